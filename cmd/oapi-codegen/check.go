@@ -0,0 +1,148 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// checkTarget is a spec, along with the config file which it's generated with.
+type checkTarget struct {
+	configFile string
+	specPath   string
+	// outputDir is the directory the relative `output` and `output-dir` of
+	// the config file are resolved against, or the working directory if empty.
+	outputDir string
+}
+
+// checkTargets returns what's to be checked by -check, which is either the
+// spec generated with the config file, if any, or each of the pairs of config
+// file and spec in args. The outputs of each of the pairs are resolved against
+// the directory of its config file, as when it's generated with `go generate`
+// from there.
+func checkTargets(configFile string, args []string) []checkTarget {
+	if configFile != "" {
+		if len(args) != 1 {
+			errExit("Only one OpenAPI 3.0 spec file is accepted along with -config, and it must be the last CLI argument\n")
+		}
+		return []checkTarget{{configFile: configFile, specPath: args[0]}}
+	}
+
+	if len(args) == 0 || len(args)%2 != 0 {
+		errExit("Please specify -config and a path to a OpenAPI 3.0 spec file, or pairs of config file and spec, to check\n")
+	}
+	var targets []checkTarget
+	for i := 0; i < len(args); i += 2 {
+		targets = append(targets, checkTarget{configFile: args[i], specPath: args[i+1], outputDir: filepath.Dir(args[i])})
+	}
+	return targets
+}
+
+// outputs generates the code of the target, keyed by the files it would be
+// written to.
+func (t checkTarget) outputs() map[string]string {
+	opts := loadConfiguration(t.configFile, t.specPath)
+	outputs := generateOutputs(opts, t.specPath)
+	if _, ok := outputs[""]; ok {
+		errExit("configuration error: `output` or `output-dir` must be specified in '%s' to check the generated code\n", t.configFile)
+	}
+	if t.outputDir == "" {
+		return outputs
+	}
+
+	resolved := make(map[string]string, len(outputs))
+	for name, code := range outputs {
+		if !filepath.IsAbs(name) {
+			name = filepath.Join(t.outputDir, name)
+		}
+		resolved[name] = code
+	}
+	return resolved
+}
+
+// runCheck generates the code for each of the targets, and compares it with
+// the files it would be written to, printing a unified diff of each file which
+// differs. It exits with a non-zero status if any do.
+func runCheck(targets []checkTarget) {
+	stale := 0
+	for _, target := range targets {
+		n, err := checkOutputs(os.Stdout, target.outputs())
+		if err != nil {
+			errExit("error checking generated code: %s\n", err)
+		}
+		stale += n
+	}
+
+	if stale > 0 {
+		errExit("%d generated files are out of date\n", stale)
+	}
+}
+
+// checkOutputs compares each of the outputs with the file it would be written
+// to, writing a unified diff to w for each which differs, and returns how many
+// differ.
+func checkOutputs(w io.Writer, outputs map[string]string) (int, error) {
+	names := make([]string, 0, len(outputs))
+	for name := range outputs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	stale := 0
+	for _, name := range names {
+		differs, err := checkFile(w, name, outputs[name])
+		if err != nil {
+			return stale, err
+		}
+		if differs {
+			stale++
+		}
+	}
+	return stale, nil
+}
+
+// checkFile compares the generated code with the contents of the file name,
+// writing a unified diff to w if they differ. A missing file differs from any
+// code.
+func checkFile(w io.Writer, name, code string) (bool, error) {
+	fromFile := name
+	existing, err := os.ReadFile(name)
+	if errors.Is(err, fs.ErrNotExist) {
+		fromFile = "/dev/null"
+	} else if err != nil {
+		return false, err
+	}
+	if err == nil && string(existing) == code {
+		return false, nil
+	}
+
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        splitLines(string(existing)),
+		B:        splitLines(code),
+		FromFile: fromFile,
+		ToFile:   name + " (generated)",
+		Context:  3,
+	})
+	if err != nil {
+		return false, fmt.Errorf("error comparing %s: %w", name, err)
+	}
+	_, err = fmt.Fprint(w, diff)
+	return true, err
+}
+
+// splitLines splits s into lines which keep their line endings. Unlike
+// difflib.SplitLines, it doesn't add an empty line after a trailing newline.
+func splitLines(s string) []string {
+	lines := strings.SplitAfter(s, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
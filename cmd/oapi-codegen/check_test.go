@@ -0,0 +1,71 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckFile(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "api.gen.go")
+	code := "package api\n\ntype Pet struct{}\n"
+
+	var out strings.Builder
+	differs, err := checkFile(&out, name, code)
+	require.NoError(t, err)
+	assert.True(t, differs)
+	assert.Equal(t, "--- /dev/null\n+++ "+name+" (generated)\n@@ -0,0 +1,3 @@\n+package api\n+\n+type Pet struct{}\n", out.String())
+
+	require.NoError(t, os.WriteFile(name, []byte(code), 0o644))
+	out.Reset()
+	differs, err = checkFile(&out, name, code)
+	require.NoError(t, err)
+	assert.False(t, differs)
+	assert.Empty(t, out.String())
+
+	out.Reset()
+	differs, err = checkFile(&out, name, "package api\n\ntype Toy struct{}\n")
+	require.NoError(t, err)
+	assert.True(t, differs)
+	assert.Contains(t, out.String(), "-type Pet struct{}\n+type Toy struct{}\n")
+}
+
+func TestCheckTargetsSiblingConfigs(t *testing.T) {
+	dir := t.TempDir()
+	var args []string
+	for _, name := range []string{"pets", "stores"} {
+		require.NoError(t, os.Mkdir(filepath.Join(dir, name), 0o755))
+		config := "package: " + name + "\ngenerate:\n  models: true\noutput: api.gen.go\n"
+		spec := "openapi: \"3.0.0\"\ninfo:\n  version: 1.0.0\n  title: " + name + "\npaths: {}\ncomponents:\n  schemas:\n    Item:\n      type: object\n      properties:\n        name:\n          type: string\n"
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name, "cfg.yaml"), []byte(config), 0o644))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name, "api.yaml"), []byte(spec), 0o644))
+		args = append(args, filepath.Join(dir, name, "cfg.yaml"), filepath.Join(dir, name, "api.yaml"))
+	}
+
+	targets := checkTargets("", args)
+	require.Len(t, targets, 2)
+	for i, name := range []string{"pets", "stores"} {
+		// The output of each config is resolved against its own directory
+		outputs := targets[i].outputs()
+		output := filepath.Join(dir, name, "api.gen.go")
+		require.Contains(t, outputs, output)
+		assert.Len(t, outputs, 1)
+
+		var out strings.Builder
+		stale, err := checkOutputs(&out, outputs)
+		require.NoError(t, err)
+		assert.Equal(t, 1, stale)
+		assert.Contains(t, out.String(), "+++ "+output+" (generated)")
+
+		require.NoError(t, os.WriteFile(output, []byte(outputs[output]), 0o644))
+		out.Reset()
+		stale, err = checkOutputs(&out, outputs)
+		require.NoError(t, err)
+		assert.Equal(t, 0, stale)
+		assert.Empty(t, out.String())
+	}
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/oapi-codegen/oapi-codegen/v2/pkg/codegen"
+	"github.com/oapi-codegen/oapi-codegen/v2/pkg/util"
+)
+
+// runDiff implements the diff command, which reports the changes between two
+// versions of a spec, and exits with a non-zero status if any of them break
+// the code generated for the old version, or its clients and servers.
+func runDiff(args []string) {
+	flags := flag.NewFlagSet("diff", flag.ExitOnError)
+	var (
+		format     string
+		configFile string
+	)
+	flags.StringVar(&format, "format", "text", "The output format, one of text or json.")
+	flags.StringVar(&configFile, "config", "", "A YAML config file that controls which operations and schemas are compared, and how they're named.")
+	flags.Usage = func() {
+		_, _ = fmt.Fprintf(flags.Output(), "Usage: %s diff [flags] old-spec new-spec\n\n", os.Args[0])
+		flags.PrintDefaults()
+	}
+	_ = flags.Parse(args)
+
+	if flags.NArg() != 2 {
+		flags.Usage()
+		os.Exit(2)
+	}
+	if format != "text" && format != "json" {
+		errExit("unknown format '%s', must be text or json\n", format)
+	}
+
+	var opts codegen.Configuration
+	if configFile != "" {
+		configData, err := os.ReadFile(configFile)
+		if err != nil {
+			errExit("error reading config file '%s': %v\n", configFile, err)
+		}
+		var cfg configuration
+		if err := yaml.Unmarshal(configData, &cfg); err != nil {
+			errExit("error parsing '%s' as YAML: %v\n", configFile, err)
+		}
+		opts = cfg.Configuration
+	}
+	opts = opts.UpdateDefaults()
+
+	oldSpec, err := util.LoadSwagger(flags.Arg(0))
+	if err != nil {
+		errExit("error loading swagger spec in %s\n: %s\n", flags.Arg(0), err)
+	}
+	newSpec, err := util.LoadSwagger(flags.Arg(1))
+	if err != nil {
+		errExit("error loading swagger spec in %s\n: %s\n", flags.Arg(1), err)
+	}
+
+	changes, err := codegen.DiffSpecs(oldSpec, newSpec, opts)
+	if err != nil {
+		errExit("error comparing specs: %s\n", err)
+	}
+
+	if format == "json" {
+		err = writeDiffJSON(os.Stdout, changes)
+	} else {
+		err = writeDiffText(os.Stdout, changes)
+	}
+	if err != nil {
+		errExit("error writing changes: %s\n", err)
+	}
+
+	for _, change := range changes {
+		if change.Breaking {
+			os.Exit(1)
+		}
+	}
+}
+
+func writeDiffJSON(w io.Writer, changes []codegen.SpecChange) error {
+	if changes == nil {
+		changes = []codegen.SpecChange{}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(changes)
+}
+
+func writeDiffText(w io.Writer, changes []codegen.SpecChange) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	breaking := 0
+	for _, change := range changes {
+		severity := "compatible"
+		if change.Breaking {
+			severity = "breaking"
+			breaking++
+		}
+		_, _ = fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", severity, change.Kind, change.Location, change.Message)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintf(w, "%d changes, %d breaking\n", len(changes), breaking)
+	return err
+}
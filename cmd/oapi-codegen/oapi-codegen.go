@@ -47,6 +47,7 @@ var (
 	flagPrintUsage     bool
 	flagGenerate       string
 	flagTemplatesDir   string
+	flagCheck          bool
 
 	// Deprecated: The options below will be removed in a future
 	// release. Please use the new config file format.
@@ -66,6 +67,10 @@ type configuration struct {
 
 	// OutputFile is the filename to output.
 	OutputFile string `yaml:"output,omitempty"`
+
+	// OutputDir is the directory to output to, when the generated code should
+	// be split into one file per concern, rather than a single OutputFile.
+	OutputDir string `yaml:"output-dir,omitempty"`
 }
 
 // oldConfiguration is deprecated. Please add no more flags here. It is here
@@ -90,11 +95,17 @@ type oldConfiguration struct {
 var noVCSVersionOverride string
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "diff" {
+		runDiff(os.Args[2:])
+		return
+	}
+
 	flag.StringVar(&flagOutputFile, "o", "", "Where to output generated code, stdout is default.")
 	flag.BoolVar(&flagOldConfigStyle, "old-config-style", false, "Whether to use the older style config file format.")
 	flag.BoolVar(&flagOutputConfig, "output-config", false, "When true, outputs a configuration file for oapi-codegen using current settings.")
 	flag.StringVar(&flagConfigFile, "config", "", "A YAML config file that controls oapi-codegen behavior.")
 	flag.BoolVar(&flagPrintVersion, "version", false, "When specified, print version and exit.")
+	flag.BoolVar(&flagCheck, "check", false, "Check that the generated code matches the existing output, rather than writing it. Pairs of config file and spec may be given to check many at once.")
 	flag.StringVar(&flagPackageName, "package", "", "The package name for generated code.")
 	flag.BoolVar(&flagPrintUsage, "help", false, "Show this help and exit.")
 	flag.BoolVar(&flagPrintUsage, "h", false, "Same as -help.")
@@ -136,12 +147,52 @@ func main() {
 		return
 	}
 
+	if flagCheck {
+		runCheck(checkTargets(flagConfigFile, flag.Args()))
+		return
+	}
+
 	if flag.NArg() < 1 {
 		errExit("Please specify a path to a OpenAPI 3.0 spec file\n")
 	} else if flag.NArg() > 1 {
 		errExit("Only one OpenAPI 3.0 spec file is accepted and it must be the last CLI argument\n")
 	}
 
+	opts := loadConfiguration(flagConfigFile, flag.Arg(0))
+
+	// If the user asked to output configuration, output it to stdout and exit
+	if flagOutputConfig {
+		buf, err := yaml.Marshal(opts)
+		if err != nil {
+			errExit("error YAML marshaling configuration: %v\n", err)
+		}
+		fmt.Print(string(buf))
+		return
+	}
+
+	for name, code := range generateOutputs(opts, flag.Arg(0)) {
+		if name == "" {
+			fmt.Print(code)
+			continue
+		}
+		writeFile(name, code)
+	}
+}
+
+// writeFile writes generated code to the file name, creating its directory if
+// needed.
+func writeFile(name, code string) {
+	if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+		errExit("error creating output directory: %s\n", err)
+	}
+	if err := os.WriteFile(name, []byte(code), 0o644); err != nil {
+		errExit("error writing generated code to file: %s\n", err)
+	}
+}
+
+// loadConfiguration loads the configuration from configFile, if given, along
+// with the command line flags, and exits if it isn't valid.
+func loadConfiguration(configFile, specPath string) configuration {
 	// We will try to infer whether the user has an old-style config, or a new
 	// style. Start with the command line argument. If it's true, we know it's
 	// old config style.
@@ -152,16 +203,16 @@ func main() {
 
 	// We don't know yet, so keep looking. Try to parse the configuration file,
 	// if given.
-	if oldConfigStyle == nil && (flagConfigFile != "") {
-		configFile, err := os.ReadFile(flagConfigFile)
+	if oldConfigStyle == nil && (configFile != "") {
+		configData, err := os.ReadFile(configFile)
 		if err != nil {
-			errExit("error reading config file '%s': %v\n", flagConfigFile, err)
+			errExit("error reading config file '%s': %v\n", configFile, err)
 		}
 		var oldConfig oldConfiguration
-		oldErr := yaml.UnmarshalStrict(configFile, &oldConfig)
+		oldErr := yaml.UnmarshalStrict(configData, &oldConfig)
 
 		var newConfig configuration
-		newErr := yaml.UnmarshalStrict(configFile, &newConfig)
+		newErr := yaml.UnmarshalStrict(configData, &newConfig)
 
 		// If one of the two files parses, but the other fails, we know the
 		// answer.
@@ -208,14 +259,14 @@ func main() {
 	var opts configuration
 	if !*oldConfigStyle {
 		// We simply read the configuration from disk.
-		if flagConfigFile != "" {
-			buf, err := os.ReadFile(flagConfigFile)
+		if configFile != "" {
+			buf, err := os.ReadFile(configFile)
 			if err != nil {
-				errExit("error reading config file '%s': %v\n", flagConfigFile, err)
+				errExit("error reading config file '%s': %v\n", configFile, err)
 			}
 			err = yaml.Unmarshal(buf, &opts)
 			if err != nil {
-				errExit("error parsing'%s' as YAML: %v\n", flagConfigFile, err)
+				errExit("error parsing'%s' as YAML: %v\n", configFile, err)
 			}
 		} else {
 			// In the case where no config file is provided, we assume some
@@ -239,14 +290,14 @@ func main() {
 		}
 	} else {
 		var oldConfig oldConfiguration
-		if flagConfigFile != "" {
-			buf, err := os.ReadFile(flagConfigFile)
+		if configFile != "" {
+			buf, err := os.ReadFile(configFile)
 			if err != nil {
-				errExit("error reading config file '%s': %v\n", flagConfigFile, err)
+				errExit("error reading config file '%s': %v\n", configFile, err)
 			}
 			err = yaml.Unmarshal(buf, &oldConfig)
 			if err != nil {
-				errExit("error parsing'%s' as YAML: %v\n", flagConfigFile, err)
+				errExit("error parsing'%s' as YAML: %v\n", configFile, err)
 			}
 		}
 		var err error
@@ -262,7 +313,7 @@ func main() {
 	// fields.
 	opts.Configuration = opts.UpdateDefaults()
 
-	if err := detectPackageName(&opts); err != nil {
+	if err := detectPackageName(&opts, specPath); err != nil {
 		errExit("%s\n", err)
 	}
 
@@ -270,17 +321,22 @@ func main() {
 	if err := opts.Validate(); err != nil {
 		errExit("configuration error: %v\n", err)
 	}
-
-	// If the user asked to output configuration, output it to stdout and exit
-	if flagOutputConfig {
-		buf, err := yaml.Marshal(opts)
-		if err != nil {
-			errExit("error YAML marshaling configuration: %v\n", err)
-		}
-		fmt.Print(string(buf))
-		return
+	if opts.OutputFile != "" && opts.OutputDir != "" {
+		errExit("configuration error: only one of `output` and `output-dir` may be specified\n")
 	}
+	if opts.OutputOptions.TagPackages != nil && opts.OutputDir == "" {
+		errExit("configuration error: `output-dir` must be specified when generating a package per tag\n")
+	}
+	if opts.Generate.SpecHandler && opts.OutputFile == "" && opts.OutputDir == "" {
+		errExit("configuration error: `output` or `output-dir` must be specified to generate the spec handler, as the assets of its docs page are written next to the generated code\n")
+	}
+
+	return opts
+}
 
+// generateOutputs generates the code for the spec at specPath, keyed by the
+// path of the file it's written to, or by "" when it's written to stdout.
+func generateOutputs(opts configuration, specPath string) map[string]string {
 	overlayOpts := util.LoadSwaggerWithOverlayOpts{
 		Path: opts.OutputOptions.Overlay.Path,
 		// default to strict, but can be overridden
@@ -291,32 +347,58 @@ func main() {
 		overlayOpts.Strict = *opts.OutputOptions.Overlay.Strict
 	}
 
-	swagger, err := util.LoadSwaggerWithOverlay(flag.Arg(0), overlayOpts)
+	swagger, err := util.LoadSwaggerWithOverlay(specPath, overlayOpts)
 	if err != nil {
-		errExit("error loading swagger spec in %s\n: %s\n", flag.Arg(0), err)
-	}
-
-	if strings.HasPrefix(swagger.OpenAPI, "3.1.") {
-		fmt.Println("WARNING: You are using an OpenAPI 3.1.x specification, which is not yet supported by oapi-codegen (https://github.com/oapi-codegen/oapi-codegen/issues/373) and so some functionality may not be available. Until oapi-codegen supports OpenAPI 3.1, it is recommended to downgrade your spec to 3.0.x")
+		errExit("error loading swagger spec in %s\n: %s\n", specPath, err)
 	}
 
 	if len(noVCSVersionOverride) > 0 {
 		opts.Configuration.NoVCSVersionOverride = &noVCSVersionOverride
 	}
 
+	outputs := make(map[string]string)
+	if opts.OutputOptions.TagPackages != nil {
+		packages, err := codegen.GenerateTagPackages(swagger, opts.Configuration)
+		if err != nil {
+			errExit("error generating code: %s\n", err)
+		}
+
+		for dir, files := range packages {
+			for name, code := range files {
+				outputs[filepath.Join(opts.OutputDir, dir, name)] = code
+			}
+		}
+		return outputs
+	}
+
+	if opts.OutputDir != "" {
+		files, err := codegen.GenerateFiles(swagger, opts.Configuration)
+		if err != nil {
+			errExit("error generating code: %s\n", err)
+		}
+
+		for name, code := range files {
+			outputs[filepath.Join(opts.OutputDir, name)] = code
+		}
+		return outputs
+	}
+
 	code, err := codegen.Generate(swagger, opts.Configuration)
 	if err != nil {
 		errExit("error generating code: %s\n", err)
 	}
+	outputs[opts.OutputFile] = code
 
-	if opts.OutputFile != "" {
-		err = os.WriteFile(opts.OutputFile, []byte(code), 0o644)
+	if opts.Generate.SpecHandler {
+		docs, err := codegen.SpecDocsFiles()
 		if err != nil {
-			errExit("error writing generated code to file: %s\n", err)
+			errExit("error generating code: %s\n", err)
+		}
+		for name, content := range docs {
+			outputs[filepath.Join(filepath.Dir(opts.OutputFile), name)] = content
 		}
-	} else {
-		fmt.Print(code)
 	}
+	return outputs
 }
 
 func loadTemplateOverrides(templatesDir string) (map[string]string, error) {
@@ -356,7 +438,7 @@ func loadTemplateOverrides(templatesDir string) (map[string]string, error) {
 }
 
 // detectPackageName detects and sets PackageName if not already set.
-func detectPackageName(cfg *configuration) error {
+func detectPackageName(cfg *configuration, specPath string) error {
 	if cfg.PackageName != "" {
 		return nil
 	}
@@ -385,7 +467,7 @@ func detectPackageName(cfg *configuration) error {
 	}
 
 	// Fallback to determining from the spec file name.
-	parts := strings.Split(filepath.Base(flag.Arg(0)), ".")
+	parts := strings.Split(filepath.Base(specPath), ".")
 	cfg.PackageName = codegen.LowercaseFirstCharacter(codegen.ToCamelCase(parts[0]))
 
 	return nil
@@ -1,8 +1,12 @@
 package main
 
 import (
+	"path/filepath"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+
+	"github.com/oapi-codegen/oapi-codegen/v2/pkg/codegen"
 	"github.com/oapi-codegen/oapi-codegen/v2/pkg/util"
 )
 
@@ -24,3 +28,23 @@ func TestLoader(t *testing.T) {
 		}
 	}
 }
+
+func TestGenerateOutputsSpecHandler(t *testing.T) {
+	opts := configuration{
+		Configuration: codegen.Configuration{
+			PackageName: "api",
+			Generate: codegen.GenerateOptions{
+				ChiServer:    true,
+				EmbeddedSpec: true,
+				SpecHandler:  true,
+			},
+		},
+		OutputFile: filepath.Join("api", "api.gen.go"),
+	}
+
+	outputs := generateOutputs(opts, "../../examples/petstore-expanded/petstore-expanded.yaml")
+
+	assert.Contains(t, outputs[opts.OutputFile], "func RegisterSpecHandlers(r chi.Router) {")
+	assert.Contains(t, outputs, filepath.Join("api", "openapi-docs", "index.html"))
+	assert.Contains(t, outputs, filepath.Join("api", "openapi-docs", "swagger-initializer.js"))
+}
@@ -3,24 +3,124 @@
 // Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.0.0-00010101000000-000000000000 DO NOT EDIT.
 package xenumnames
 
+import (
+	"fmt"
+)
+
 // Defines values for ClientType.
 const (
 	ACT ClientType = "ACT"
 	EXP ClientType = "EXP"
 )
 
+// ClientTypeValues returns the values of ClientType, in the order they're declared in the spec.
+func ClientTypeValues() []ClientType {
+	return []ClientType{
+		ACT,
+		EXP,
+	}
+}
+
+// Valid returns whether e is one of the values of ClientType.
+func (e ClientType) Valid() bool {
+	switch e {
+	case ACT, EXP:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns e as it's written in the spec.
+func (e ClientType) String() string {
+	return string(e)
+}
+
+// ParseClientType returns the value of ClientType written as s, or an error if s isn't one of its values.
+func ParseClientType(s string) (ClientType, error) {
+	e := ClientType(s)
+	if !e.Valid() {
+		return "", fmt.Errorf("invalid value %q for ClientType", s)
+	}
+	return e, nil
+}
+
 // Defines values for ClientTypeWithNamesExtension.
 const (
 	ClientTypeWithNamesExtensionActive  ClientTypeWithNamesExtension = "ACT"
 	ClientTypeWithNamesExtensionExpired ClientTypeWithNamesExtension = "EXP"
 )
 
+// ClientTypeWithNamesExtensionValues returns the values of ClientTypeWithNamesExtension, in the order they're declared in the spec.
+func ClientTypeWithNamesExtensionValues() []ClientTypeWithNamesExtension {
+	return []ClientTypeWithNamesExtension{
+		ClientTypeWithNamesExtensionActive,
+		ClientTypeWithNamesExtensionExpired,
+	}
+}
+
+// Valid returns whether e is one of the values of ClientTypeWithNamesExtension.
+func (e ClientTypeWithNamesExtension) Valid() bool {
+	switch e {
+	case ClientTypeWithNamesExtensionActive, ClientTypeWithNamesExtensionExpired:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns e as it's written in the spec.
+func (e ClientTypeWithNamesExtension) String() string {
+	return string(e)
+}
+
+// ParseClientTypeWithNamesExtension returns the value of ClientTypeWithNamesExtension written as s, or an error if s isn't one of its values.
+func ParseClientTypeWithNamesExtension(s string) (ClientTypeWithNamesExtension, error) {
+	e := ClientTypeWithNamesExtension(s)
+	if !e.Valid() {
+		return "", fmt.Errorf("invalid value %q for ClientTypeWithNamesExtension", s)
+	}
+	return e, nil
+}
+
 // Defines values for ClientTypeWithVarNamesExtension.
 const (
 	ClientTypeWithVarNamesExtensionActive  ClientTypeWithVarNamesExtension = "ACT"
 	ClientTypeWithVarNamesExtensionExpired ClientTypeWithVarNamesExtension = "EXP"
 )
 
+// ClientTypeWithVarNamesExtensionValues returns the values of ClientTypeWithVarNamesExtension, in the order they're declared in the spec.
+func ClientTypeWithVarNamesExtensionValues() []ClientTypeWithVarNamesExtension {
+	return []ClientTypeWithVarNamesExtension{
+		ClientTypeWithVarNamesExtensionActive,
+		ClientTypeWithVarNamesExtensionExpired,
+	}
+}
+
+// Valid returns whether e is one of the values of ClientTypeWithVarNamesExtension.
+func (e ClientTypeWithVarNamesExtension) Valid() bool {
+	switch e {
+	case ClientTypeWithVarNamesExtensionActive, ClientTypeWithVarNamesExtensionExpired:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns e as it's written in the spec.
+func (e ClientTypeWithVarNamesExtension) String() string {
+	return string(e)
+}
+
+// ParseClientTypeWithVarNamesExtension returns the value of ClientTypeWithVarNamesExtension written as s, or an error if s isn't one of its values.
+func ParseClientTypeWithVarNamesExtension(s string) (ClientTypeWithVarNamesExtension, error) {
+	e := ClientTypeWithVarNamesExtension(s)
+	if !e.Valid() {
+		return "", fmt.Errorf("invalid value %q for ClientTypeWithVarNamesExtension", s)
+	}
+	return e, nil
+}
+
 // ClientType defines model for ClientType.
 type ClientType string
 
@@ -0,0 +1,534 @@
+// Package defaults provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.0.0-00010101000000-000000000000 DO NOT EDIT.
+package defaults
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/oapi-codegen/runtime"
+)
+
+// Defines values for Order.
+const (
+	Asc  Order = "asc"
+	Desc Order = "desc"
+)
+
+// Order defines model for Order.
+type Order string
+
+// Owner defines model for Owner.
+type Owner struct {
+	City *string `json:"city,omitempty"`
+}
+
+// Pet defines model for Pet.
+type Pet struct {
+	Age        *int               `json:"age,omitempty"`
+	Attributes *map[string]string `json:"attributes,omitempty"`
+	Collar     *struct {
+		Color *string `json:"color,omitempty"`
+	} `json:"collar,omitempty"`
+	Kind       *string   `json:"kind,omitempty"`
+	Name       string    `json:"name"`
+	Owner      *Owner    `json:"owner,omitempty"`
+	Steps      *int      `json:"steps,omitempty"`
+	Tags       *[]string `json:"tags,omitempty"`
+	Toys       *[]Toy    `json:"toys,omitempty"`
+	Vaccinated *bool     `json:"vaccinated,omitempty"`
+	Weight     *float64  `json:"weight,omitempty"`
+}
+
+// Shelter defines model for Shelter.
+type Shelter struct {
+	Capacity             *int           `json:"capacity,omitempty"`
+	AdditionalProperties map[string]Pet `json:"-"`
+}
+
+// Toy defines model for Toy.
+type Toy struct {
+	Kind    *string `json:"kind,omitempty"`
+	Squeaky *bool   `json:"squeaky,omitempty"`
+}
+
+// ListPetsParams defines parameters for ListPets.
+type ListPetsParams struct {
+	Limit   *int    `form:"limit,omitempty" json:"limit,omitempty"`
+	Order   *Order  `form:"order,omitempty" json:"order,omitempty"`
+	XRegion *string `json:"X-Region,omitempty"`
+}
+
+// AddPetJSONRequestBody defines body for AddPet for application/json ContentType.
+type AddPetJSONRequestBody = Pet
+
+// Getter for additional properties for Shelter. Returns the specified
+// element and whether it was found
+func (a Shelter) Get(fieldName string) (value Pet, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for Shelter
+func (a *Shelter) Set(fieldName string, value Pet) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]Pet)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for Shelter to handle AdditionalProperties
+func (a *Shelter) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["capacity"]; found {
+		err = json.Unmarshal(raw, &a.Capacity)
+		if err != nil {
+			return fmt.Errorf("error reading 'capacity': %w", err)
+		}
+		delete(object, "capacity")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]Pet)
+		for fieldName, fieldBuf := range object {
+			var fieldVal Pet
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	if d, ok := interface{}(a).(interface{ ApplyDefaults() }); ok {
+		d.ApplyDefaults()
+	}
+	return nil
+}
+
+// Override default JSON handling for Shelter to handle AdditionalProperties
+func (a Shelter) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	if a.Capacity != nil {
+		object["capacity"], err = json.Marshal(a.Capacity)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'capacity': %w", err)
+		}
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// NewOwner returns a Owner with the defaults of its schema applied.
+func NewOwner() Owner {
+	var t Owner
+	t.ApplyDefaults()
+	return t
+}
+
+// ApplyDefaults sets the properties which are unset to the defaults of their
+// schemas, including within the nested objects, arrays and maps.
+func (t *Owner) ApplyDefaults() {
+	if t.City == nil {
+		v0 := "Paris"
+		t.City = &v0
+	}
+}
+
+// UnmarshalJSON unmarshals the Owner, and applies the defaults of the
+// properties which are missing.
+func (t *Owner) UnmarshalJSON(data []byte) error {
+	type plain Owner
+	if err := json.Unmarshal(data, (*plain)(t)); err != nil {
+		return err
+	}
+	t.ApplyDefaults()
+	return nil
+}
+
+// NewPet returns a Pet with the defaults of its schema applied.
+func NewPet() Pet {
+	var t Pet
+	t.ApplyDefaults()
+	return t
+}
+
+// ApplyDefaults sets the properties which are unset to the defaults of their
+// schemas, including within the nested objects, arrays and maps.
+func (t *Pet) ApplyDefaults() {
+	if t.Age == nil {
+		v0 := int(1)
+		t.Age = &v0
+	}
+	if t.Attributes == nil {
+		var v1 map[string]string
+		if err := json.Unmarshal([]byte(`{"size":"small"}`), &v1); err == nil {
+			t.Attributes = &v1
+		}
+	}
+	if t.Collar != nil {
+		if t.Collar.Color == nil {
+			v2 := "red"
+			t.Collar.Color = &v2
+		}
+	}
+	if t.Kind == nil {
+		v3 := "dog"
+		t.Kind = &v3
+	}
+	if t.Owner != nil {
+		t.Owner.ApplyDefaults()
+	}
+	if t.Steps == nil {
+		v4 := int(1000000)
+		t.Steps = &v4
+	}
+	if t.Tags == nil {
+		v5 := []string{"new"}
+		t.Tags = &v5
+	}
+	if t.Toys != nil {
+		for i6 := range *t.Toys {
+			(*t.Toys)[i6].ApplyDefaults()
+		}
+	}
+	if t.Vaccinated == nil {
+		v7 := true
+		t.Vaccinated = &v7
+	}
+	if t.Weight == nil {
+		v8 := float64(1)
+		t.Weight = &v8
+	}
+}
+
+// UnmarshalJSON unmarshals the Pet, and applies the defaults of the
+// properties which are missing.
+func (t *Pet) UnmarshalJSON(data []byte) error {
+	type plain Pet
+	if err := json.Unmarshal(data, (*plain)(t)); err != nil {
+		return err
+	}
+	t.ApplyDefaults()
+	return nil
+}
+
+// NewShelter returns a Shelter with the defaults of its schema applied.
+func NewShelter() Shelter {
+	var t Shelter
+	t.ApplyDefaults()
+	return t
+}
+
+// ApplyDefaults sets the properties which are unset to the defaults of their
+// schemas, including within the nested objects, arrays and maps.
+func (t *Shelter) ApplyDefaults() {
+	if t.Capacity == nil {
+		v0 := int(10)
+		t.Capacity = &v0
+	}
+	for k1, v2 := range t.AdditionalProperties {
+		v2.ApplyDefaults()
+		t.AdditionalProperties[k1] = v2
+	}
+}
+
+// NewToy returns a Toy with the defaults of its schema applied.
+func NewToy() Toy {
+	var t Toy
+	t.ApplyDefaults()
+	return t
+}
+
+// ApplyDefaults sets the properties which are unset to the defaults of their
+// schemas, including within the nested objects, arrays and maps.
+func (t *Toy) ApplyDefaults() {
+	if t.Kind == nil {
+		v0 := "ball"
+		t.Kind = &v0
+	}
+	if t.Squeaky == nil {
+		v1 := false
+		t.Squeaky = &v1
+	}
+}
+
+// UnmarshalJSON unmarshals the Toy, and applies the defaults of the
+// properties which are missing.
+func (t *Toy) UnmarshalJSON(data []byte) error {
+	type plain Toy
+	if err := json.Unmarshal(data, (*plain)(t)); err != nil {
+		return err
+	}
+	t.ApplyDefaults()
+	return nil
+}
+
+// NewListPetsParams returns a ListPetsParams with the defaults of its schema applied.
+func NewListPetsParams() ListPetsParams {
+	var t ListPetsParams
+	t.ApplyDefaults()
+	return t
+}
+
+// ApplyDefaults sets the properties which are unset to the defaults of their
+// schemas, including within the nested objects, arrays and maps.
+func (t *ListPetsParams) ApplyDefaults() {
+	if t.Limit == nil {
+		v0 := int(20)
+		t.Limit = &v0
+	}
+	if t.Order == nil {
+		v1 := Order("asc")
+		t.Order = &v1
+	}
+	if t.XRegion == nil {
+		v2 := "eu"
+		t.XRegion = &v2
+	}
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+
+	// (GET /pets)
+	ListPets(w http.ResponseWriter, r *http.Request, params ListPetsParams)
+
+	// (POST /pets)
+	AddPet(w http.ResponseWriter, r *http.Request)
+}
+
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+
+type Unimplemented struct{}
+
+// (GET /pets)
+func (_ Unimplemented) ListPets(w http.ResponseWriter, r *http.Request, params ListPetsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /pets)
+func (_ Unimplemented) AddPet(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// ListPets operation middleware
+func (siw *ServerInterfaceWrapper) ListPets(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListPetsParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", r.URL.Query(), &params.Order)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Region" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Region")]; found {
+		var XRegion string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Region", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Region", valueList[0], &XRegion, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Region", Err: err})
+			return
+		}
+
+		params.XRegion = &XRegion
+
+	}
+
+	params.ApplyDefaults()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListPets(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AddPet operation middleware
+func (siw *ServerInterfaceWrapper) AddPet(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddPet(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/pets", wrapper.ListPets)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pets", wrapper.AddPet)
+	})
+
+	return r
+}
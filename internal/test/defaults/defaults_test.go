@@ -0,0 +1,128 @@
+package defaults
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAppliesDefaults(t *testing.T) {
+	pet := NewPet()
+	assert.Equal(t, "dog", *pet.Kind)
+	assert.Equal(t, 1, *pet.Age)
+	assert.Equal(t, 1.0, *pet.Weight)
+	assert.Equal(t, 1000000, *pet.Steps)
+	assert.True(t, *pet.Vaccinated)
+	assert.Equal(t, []string{"new"}, *pet.Tags)
+	assert.Equal(t, map[string]string{"size": "small"}, *pet.Attributes)
+
+	// Nested objects which are unset aren't created
+	assert.Nil(t, pet.Owner)
+	assert.Nil(t, pet.Collar)
+}
+
+func TestUnmarshalAppliesDefaults(t *testing.T) {
+	var pet Pet
+	err := json.Unmarshal([]byte(`{"name": "Rex", "kind": "cat", "age": null, "owner": {}, "toys": [{}, {"kind": "bone"}], "collar": {}}`), &pet)
+	require.NoError(t, err)
+
+	assert.Equal(t, "Rex", pet.Name)
+	assert.Equal(t, "cat", *pet.Kind)
+	assert.Equal(t, 1, *pet.Age)
+	assert.Equal(t, "Paris", *pet.Owner.City)
+	require.Len(t, *pet.Toys, 2)
+	assert.Equal(t, "ball", *(*pet.Toys)[0].Kind)
+	assert.Equal(t, "bone", *(*pet.Toys)[1].Kind)
+	assert.False(t, *(*pet.Toys)[1].Squeaky)
+	assert.Equal(t, "red", *pet.Collar.Color)
+}
+
+func TestUnmarshalAdditionalPropertiesAppliesDefaults(t *testing.T) {
+	var shelter Shelter
+	err := json.Unmarshal([]byte(`{"rex": {"name": "Rex"}}`), &shelter)
+	require.NoError(t, err)
+
+	assert.Equal(t, 10, *shelter.Capacity)
+	rex, found := shelter.Get("rex")
+	require.True(t, found)
+	assert.Equal(t, "dog", *rex.Kind)
+}
+
+type server struct {
+	Unimplemented
+}
+
+func (s server) ListPets(w http.ResponseWriter, r *http.Request, params ListPetsParams) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(params)
+}
+
+func (s server) AddPet(w http.ResponseWriter, r *http.Request) {
+	var pet Pet
+	if err := json.NewDecoder(r.Body).Decode(&pet); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(pet)
+}
+
+func TestServerAppliesParameterDefaults(t *testing.T) {
+	handler := Handler(server{})
+
+	tests := []struct {
+		name   string
+		query  string
+		region string
+		want   ListPetsParams
+	}{
+		{
+			name: "missing",
+			want: NewListPetsParams(),
+		},
+		{
+			name:   "present",
+			query:  "?limit=5&order=desc",
+			region: "us",
+			want: ListPetsParams{
+				Limit:   ptr(5),
+				Order:   ptr(Desc),
+				XRegion: ptr("us"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/pets"+tt.query, nil)
+			if tt.region != "" {
+				req.Header.Set("X-Region", tt.region)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			require.Equal(t, http.StatusOK, rec.Code)
+
+			var params ListPetsParams
+			require.NoError(t, json.NewDecoder(rec.Body).Decode(&params))
+			assert.Equal(t, tt.want, params)
+		})
+	}
+}
+
+func TestServerAppliesBodyDefaults(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPost, "/pets", strings.NewReader(`{"name": "Rex"}`))
+	rec := httptest.NewRecorder()
+	Handler(server{}).ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	assert.JSONEq(t, `{"name": "Rex", "kind": "dog", "age": 1, "weight": 1, "steps": 1000000, "vaccinated": true, "tags": ["new"], "attributes": {"size": "small"}}`, rec.Body.String())
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,159 @@
+// Package enums provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.0.0-00010101000000-000000000000 DO NOT EDIT.
+package enums
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// Defines values for PetStatus.
+const (
+	Available PetStatus = "available"
+	Pending   PetStatus = "pending"
+	Sold      PetStatus = "sold"
+)
+
+// PetStatusValues returns the values of PetStatus, in the order they're declared in the spec.
+func PetStatusValues() []PetStatus {
+	return []PetStatus{
+		Available,
+		Pending,
+		Sold,
+	}
+}
+
+// Valid returns whether e is one of the values of PetStatus.
+func (e PetStatus) Valid() bool {
+	switch e {
+	case Available, Pending, Sold:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns e as it's written in the spec.
+func (e PetStatus) String() string {
+	return string(e)
+}
+
+// ParsePetStatus returns the value of PetStatus written as s, or an error if s isn't one of its values.
+func ParsePetStatus(s string) (PetStatus, error) {
+	e := PetStatus(s)
+	if !e.Valid() {
+		return "", fmt.Errorf("invalid value %q for PetStatus", s)
+	}
+	return e, nil
+}
+
+// UnmarshalJSON unmarshals e, returning an error if it isn't one of the values of PetStatus.
+func (e *PetStatus) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var v string
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	if !PetStatus(v).Valid() {
+		return fmt.Errorf("invalid value %s for PetStatus", data)
+	}
+	*e = PetStatus(v)
+	return nil
+}
+
+// UnmarshalText unmarshals e, returning an error if it isn't one of the values of PetStatus.
+func (e *PetStatus) UnmarshalText(text []byte) error {
+	v, err := ParsePetStatus(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
+// Defines values for Priority.
+const (
+	N1 Priority = 1
+	N2 Priority = 2
+	N3 Priority = 3
+)
+
+// PriorityValues returns the values of Priority, in the order they're declared in the spec.
+func PriorityValues() []Priority {
+	return []Priority{
+		N3,
+		N1,
+		N2,
+	}
+}
+
+// Valid returns whether e is one of the values of Priority.
+func (e Priority) Valid() bool {
+	switch e {
+	case N3, N1, N2:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns e as it's written in the spec.
+func (e Priority) String() string {
+	return strconv.FormatInt(int64(e), 10)
+}
+
+// ParsePriority returns the value of Priority written as s, or an error if s isn't one of its values.
+func ParsePriority(s string) (Priority, error) {
+	v, err := strconv.ParseInt(s, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q for Priority: %w", s, err)
+	}
+	e := Priority(v)
+	if !e.Valid() {
+		return 0, fmt.Errorf("invalid value %q for Priority", s)
+	}
+	return e, nil
+}
+
+// UnmarshalJSON unmarshals e, returning an error if it isn't one of the values of Priority.
+func (e *Priority) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var v int32
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	if !Priority(v).Valid() {
+		return fmt.Errorf("invalid value %s for Priority", data)
+	}
+	*e = Priority(v)
+	return nil
+}
+
+// UnmarshalText unmarshals e, returning an error if it isn't one of the values of Priority.
+func (e *Priority) UnmarshalText(text []byte) error {
+	v, err := ParsePriority(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
+// Pet defines model for Pet.
+type Pet struct {
+	Priority *Priority          `json:"priority,omitempty"`
+	Sizes    *map[PetStatus]int `json:"sizes,omitempty"`
+	Status   PetStatus          `json:"status"`
+}
+
+// PetStatus defines model for PetStatus.
+type PetStatus string
+
+// Priority defines model for Priority.
+type Priority int32
@@ -0,0 +1,91 @@
+package enums
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnumValues(t *testing.T) {
+	assert.Equal(t, []PetStatus{Available, Pending, Sold}, PetStatusValues())
+	assert.Equal(t, []Priority{N3, N1, N2}, PriorityValues())
+}
+
+func TestEnumValid(t *testing.T) {
+	assert.True(t, Pending.Valid())
+	assert.False(t, PetStatus("bogus").Valid())
+	assert.True(t, Priority(2).Valid())
+	assert.False(t, Priority(4).Valid())
+}
+
+func TestEnumString(t *testing.T) {
+	assert.Equal(t, "sold", Sold.String())
+	assert.Equal(t, "3", N3.String())
+}
+
+func TestParseEnum(t *testing.T) {
+	status, err := ParsePetStatus("pending")
+	require.NoError(t, err)
+	assert.Equal(t, Pending, status)
+
+	_, err = ParsePetStatus("bogus")
+	assert.EqualError(t, err, `invalid value "bogus" for PetStatus`)
+
+	priority, err := ParsePriority("1")
+	require.NoError(t, err)
+	assert.Equal(t, N1, priority)
+
+	_, err = ParsePriority("4")
+	assert.EqualError(t, err, `invalid value "4" for Priority`)
+
+	_, err = ParsePriority("one")
+	assert.ErrorContains(t, err, `invalid value "one" for Priority: `)
+}
+
+func TestStrictEnumUnmarshal(t *testing.T) {
+	var pet Pet
+	require.NoError(t, json.Unmarshal([]byte(`{"status": "sold", "priority": 3, "sizes": {"pending": 2}}`), &pet))
+	assert.Equal(t, Sold, pet.Status)
+	assert.Equal(t, N3, *pet.Priority)
+	assert.Equal(t, map[PetStatus]int{Pending: 2}, *pet.Sizes)
+
+	require.NoError(t, json.Unmarshal([]byte(`{"status": "sold", "priority": null}`), &pet))
+	assert.Nil(t, pet.Priority)
+
+	tests := []struct {
+		name string
+		data string
+		err  string
+	}{
+		{
+			name: "string",
+			data: `{"status": "bogus"}`,
+			err:  `invalid value "bogus" for PetStatus`,
+		},
+		{
+			name: "integer",
+			data: `{"status": "sold", "priority": 4}`,
+			err:  `invalid value 4 for Priority`,
+		},
+		{
+			name: "wrong type",
+			data: `{"status": 1}`,
+			err:  `cannot unmarshal number`,
+		},
+		{
+			name: "map key",
+			data: `{"status": "sold", "sizes": {"bogus": 1}}`,
+			err:  `invalid value "bogus" for PetStatus`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var pet Pet
+			err := json.Unmarshal([]byte(tt.data), &pet)
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+}
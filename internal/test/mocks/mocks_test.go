@@ -0,0 +1,104 @@
+package mocks
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMockClient(t *testing.T) {
+	mock := &MockClient{
+		AddPetFunc: func(ctx context.Context, body AddPetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+			return &http.Response{StatusCode: http.StatusCreated}, nil
+		},
+	}
+	var client ClientInterface = mock
+
+	editor := func(ctx context.Context, req *http.Request) error { return nil }
+	rsp, err := client.AddPet(context.Background(), AddPetJSONRequestBody{Name: "Fido"}, editor)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, rsp.StatusCode)
+
+	calls := mock.AddPetCalls()
+	require.Len(t, calls, 1)
+	assert.Equal(t, Pet{Name: "Fido"}, calls[0].Body)
+	assert.Len(t, calls[0].ReqEditors, 1)
+
+	// The calls returned are a copy
+	calls[0].Body.Name = "Rex"
+	assert.Equal(t, "Fido", mock.AddPetCalls()[0].Body.Name)
+
+	assert.Empty(t, mock.FindPetsCalls())
+	assert.PanicsWithValue(t, "MockClient.FindPetsFunc must be set to call ClientInterface.FindPets", func() {
+		_, _ = client.FindPets(context.Background(), nil)
+	})
+	assert.Len(t, mock.FindPetsCalls(), 1)
+}
+
+func TestMockClientWithResponses(t *testing.T) {
+	limit := 2
+	mock := &MockClientWithResponses{
+		FindPetsWithResponseFunc: func(ctx context.Context, params *FindPetsParams, reqEditors ...RequestEditorFn) (*FindPetsResponse, error) {
+			pets := []Pet{{Name: "Fido"}, {Name: "Rex"}}[:*params.Limit]
+			return &FindPetsResponse{JSON200: &pets}, nil
+		},
+	}
+
+	var client ClientWithResponsesInterface = mock
+	rsp, err := client.FindPetsWithResponse(context.Background(), &FindPetsParams{Limit: &limit})
+	require.NoError(t, err)
+	assert.Len(t, *rsp.JSON200, 2)
+
+	calls := mock.FindPetsWithResponseCalls()
+	require.Len(t, calls, 1)
+	assert.Equal(t, &limit, calls[0].Params.Limit)
+}
+
+func TestMockServer(t *testing.T) {
+	mock := &MockServer{
+		DeletePetFunc: func(w http.ResponseWriter, r *http.Request, id int) {
+			w.WriteHeader(http.StatusNoContent)
+		},
+	}
+
+	rec := httptest.NewRecorder()
+	Handler(mock).ServeHTTP(rec, httptest.NewRequest(http.MethodDelete, "/pets/7", nil))
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+
+	calls := mock.DeletePetCalls()
+	require.Len(t, calls, 1)
+	assert.Equal(t, 7, calls[0].Id)
+	assert.Equal(t, "/pets/7", calls[0].R.URL.Path)
+}
+
+func TestMockStrictServer(t *testing.T) {
+	mock := &MockStrictServer{
+		FindPetsFunc: func(ctx context.Context, request FindPetsRequestObject) (FindPetsResponseObject, error) {
+			return FindPets200JSONResponse{{Name: "Fido"}}, nil
+		},
+	}
+
+	// Calls are recorded safely from concurrent requests
+	handler := Handler(NewStrictHandler(mock, nil))
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/pets?limit=1", nil))
+			assert.JSONEq(t, `[{"name": "Fido"}]`, rec.Body.String())
+		}()
+	}
+	wg.Wait()
+
+	calls := mock.FindPetsCalls()
+	require.Len(t, calls, 10)
+	require.NotNil(t, calls[0].Request.Params.Limit)
+	assert.Equal(t, 1, *calls[0].Request.Params.Limit)
+}
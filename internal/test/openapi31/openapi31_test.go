@@ -0,0 +1,77 @@
+package openapi31
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTypeArrays(t *testing.T) {
+	var pet Pet
+	err := json.Unmarshal([]byte(`{"kind":"pet","name":"Rex","nickname":null,"age":3,"status":null,"identifier":42}`), &pet)
+	require.NoError(t, err)
+
+	assert.Nil(t, pet.Nickname)
+	require.NotNil(t, pet.Age)
+	assert.Equal(t, 3, *pet.Age)
+	assert.Nil(t, pet.Status)
+	require.NotNil(t, pet.Identifier)
+	assert.Equal(t, float64(42), *pet.Identifier)
+
+	// Nullable properties are sent as null, rather than omitted
+	buf, err := json.Marshal(Pet{Kind: PetKindPet, Name: "Rex"})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"kind":"pet","name":"Rex","nickname":null,"age":null,"status":null,"metadata":null}`, string(buf))
+}
+
+func TestConst(t *testing.T) {
+	assert.Equal(t, PetKind("pet"), PetKindPet)
+	assert.Equal(t, TagLabel("tag"), TagLabelTag)
+	assert.Equal(t, TagVersion(1), N1)
+}
+
+func TestNullableEnum(t *testing.T) {
+	var pet Pet
+	err := json.Unmarshal([]byte(`{"kind":"pet","name":"Rex","nickname":null,"status":"sold"}`), &pet)
+	require.NoError(t, err)
+
+	require.NotNil(t, pet.Status)
+	assert.Equal(t, Sold, *pet.Status)
+}
+
+func TestDefs(t *testing.T) {
+	var pet Pet
+	err := json.Unmarshal([]byte(`{"kind":"pet","name":"Rex","nickname":null,"owner":{"name":"Alice","address":{"city":"London"}},"tags":["good"]}`), &pet)
+	require.NoError(t, err)
+
+	require.NotNil(t, pet.Owner)
+	assert.Equal(t, "Alice", pet.Owner.Name)
+	require.NotNil(t, pet.Owner.Address)
+	assert.Equal(t, "London", *pet.Owner.Address.City)
+	// PetTag is prefixed with the name of the schema it was defined in, as
+	// it clashes with the Tag component
+	require.NotNil(t, pet.Tags)
+	assert.Equal(t, []PetTag{"good"}, *pet.Tags)
+
+	// Operations can refer to $defs too
+	var response GetPetOwnerResponse
+	assert.IsType(t, &Owner{}, response.JSON200)
+}
+
+func TestSpec(t *testing.T) {
+	swagger, err := GetSwagger()
+	require.NoError(t, err)
+	assert.Equal(t, "3.1.0", swagger.OpenAPI)
+
+	// Numeric exclusive bounds are converted to the OpenAPI 3.0 form
+	age := swagger.Components.Schemas["Pet"].Value.Properties["age"].Value
+	require.NotNil(t, age.Min)
+	assert.Equal(t, float64(0), *age.Min)
+	assert.True(t, age.ExclusiveMin)
+	require.NotNil(t, age.Max)
+	assert.Equal(t, float64(100), *age.Max)
+	assert.True(t, age.ExclusiveMax)
+	assert.True(t, age.Nullable)
+}
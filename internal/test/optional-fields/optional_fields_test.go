@@ -0,0 +1,106 @@
+package optionalfields
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/oapi-codegen/nullable"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// server patches the pet it stores with the fields which are specified.
+type server struct {
+	pet Pet
+}
+
+func (s *server) UpdatePet(ctx context.Context, request UpdatePetRequestObject) (UpdatePetResponseObject, error) {
+	patch := request.Body
+	if name, err := patch.Name.Get(); err == nil {
+		s.pet.Name = name
+	}
+	if patch.Tag.IsSpecified() {
+		s.pet.Tag = patch.Tag
+	}
+	if patch.Age.IsSpecified() {
+		s.pet.Age = patch.Age
+	}
+	return UpdatePet200JSONResponse(s.pet), nil
+}
+
+func TestOptionalFields(t *testing.T) {
+	s := &server{pet: Pet{Id: 1, Name: "Rex", Tag: nullable.NewNullableWithValue("dog"), Age: nullable.NewNullableWithValue(3)}}
+	ts := httptest.NewServer(Handler(NewStrictHandler(s, nil)))
+	defer ts.Close()
+
+	client, err := NewClientWithResponses(ts.URL)
+	require.NoError(t, err)
+
+	// The tag is cleared, while the age is left as it is
+	rsp, err := client.UpdatePetWithResponse(context.Background(), 1, nil, UpdatePetJSONRequestBody{
+		Name: nullable.NewNullableWithValue("Max"),
+		Tag:  nullable.NewNullNullable[string](),
+	})
+	require.NoError(t, err)
+	require.NotNil(t, rsp.JSON200)
+
+	assert.Equal(t, Pet{Id: 1, Name: "Max", Tag: nullable.NewNullNullable[string](), Age: nullable.NewNullableWithValue(3)}, *rsp.JSON200)
+	assert.JSONEq(t, `{"id": 1, "name": "Max", "tag": null, "age": 3}`, string(rsp.Body))
+}
+
+func TestOptionalMarshal(t *testing.T) {
+	tests := []struct {
+		name  string
+		patch PetPatch
+		json  string
+	}{
+		{
+			name:  "unset",
+			patch: PetPatch{},
+			json:  `{}`,
+		},
+		{
+			name:  "null",
+			patch: PetPatch{Age: nullable.NewNullNullable[int]()},
+			json:  `{"age": null}`,
+		},
+		{
+			name:  "value",
+			patch: PetPatch{Age: nullable.NewNullableWithValue(0)},
+			json:  `{"age": 0}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.patch)
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.json, string(data))
+
+			var patch PetPatch
+			require.NoError(t, json.Unmarshal(data, &patch))
+			// The default of the status is applied when unmarshaling
+			patch.Status = nil
+			assert.Equal(t, tt.patch, patch)
+		})
+	}
+}
+
+func TestFieldRepresentationExtension(t *testing.T) {
+	// The properties of Owner are pointers
+	data, err := json.Marshal(Owner{})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"email": null}`, string(data))
+
+	var patch PetPatch
+	require.NoError(t, json.Unmarshal([]byte(`{"owner": {"name": "Alice"}}`), &patch))
+	owner, err := patch.Owner.Get()
+	require.NoError(t, err)
+	assert.Equal(t, "Alice", *owner.Name)
+	assert.Nil(t, owner.Email)
+	assert.Equal(t, "available", *patch.Status)
+
+	assert.EqualError(t, PetPatch{Age: nullable.NewNullableWithValue(-1)}.Validate(), "/age: must be >= 0")
+}
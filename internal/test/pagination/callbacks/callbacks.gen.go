@@ -0,0 +1,576 @@
+// Package callbacks provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.0.0-00010101000000-000000000000 DO NOT EDIT.
+package callbacks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/oapi-codegen/runtime"
+)
+
+// Thing defines model for Thing.
+type Thing struct {
+	Name string `json:"name"`
+}
+
+// ListThingsParams defines parameters for ListThings.
+type ListThingsParams struct {
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+	Limit  *int    `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// ListOwnerThings request
+	ListOwnerThings(ctx context.Context, owner string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListThings request
+	ListThings(ctx context.Context, params *ListThingsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) ListOwnerThings(ctx context.Context, owner string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListOwnerThingsRequest(c.Server, owner)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListThings(ctx context.Context, params *ListThingsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListThingsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewListOwnerThingsRequest generates requests for ListOwnerThings
+func NewListOwnerThingsRequest(server string, owner string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/owners/%s/things", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListThingsRequest generates requests for ListThings
+func NewListThingsRequest(server string, params *ListThingsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/things")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// ListOwnerThingsWithResponse request
+	ListOwnerThingsWithResponse(ctx context.Context, owner string, reqEditors ...RequestEditorFn) (*ListOwnerThingsResponse, error)
+
+	// ListThingsWithResponse request
+	ListThingsWithResponse(ctx context.Context, params *ListThingsParams, reqEditors ...RequestEditorFn) (*ListThingsResponse, error)
+}
+
+type ListOwnerThingsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Thing
+}
+
+// Status returns HTTPResponse.Status
+func (r ListOwnerThingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListOwnerThingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListThingsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Data []Thing `json:"data"`
+		Meta *struct {
+			NextCursor *string `json:"next_cursor,omitempty"`
+		} `json:"meta,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r ListThingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListThingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ListOwnerThingsWithResponse request returning *ListOwnerThingsResponse
+func (c *ClientWithResponses) ListOwnerThingsWithResponse(ctx context.Context, owner string, reqEditors ...RequestEditorFn) (*ListOwnerThingsResponse, error) {
+	rsp, err := c.ListOwnerThings(ctx, owner, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListOwnerThingsResponse(rsp)
+}
+
+// ListThingsWithResponse request returning *ListThingsResponse
+func (c *ClientWithResponses) ListThingsWithResponse(ctx context.Context, params *ListThingsParams, reqEditors ...RequestEditorFn) (*ListThingsResponse, error) {
+	rsp, err := c.ListThings(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListThingsResponse(rsp)
+}
+
+// ParseListOwnerThingsResponse parses an HTTP response from a ListOwnerThingsWithResponse call
+func ParseListOwnerThingsResponse(rsp *http.Response) (*ListOwnerThingsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListOwnerThingsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Thing
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListThingsResponse parses an HTTP response from a ListThingsWithResponse call
+func ParseListThingsResponse(rsp *http.Response) (*ListThingsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListThingsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Data []Thing `json:"data"`
+			Meta *struct {
+				NextCursor *string `json:"next_cursor,omitempty"`
+			} `json:"meta,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ListOwnerThingsAll requests every page of ListOwnerThings, starting with the one selected
+// by params, and calls fn with each of their items in turn, until fn returns
+// false.
+func (c *ClientWithResponses) ListOwnerThingsAll(ctx context.Context, owner string, fn func(item Thing) bool, reqEditors ...RequestEditorFn) error {
+	return c.walkListOwnerThingsPages(ctx, owner, reqEditors, fn)
+}
+
+// walkListOwnerThingsPages requests each page of ListOwnerThings in turn, calling fn with
+// their items until it returns false.
+func (c *ClientWithResponses) walkListOwnerThingsPages(ctx context.Context, owner string, reqEditors []RequestEditorFn, fn func(item Thing) bool) error {
+	var next *url.URL
+	// requested holds the pages which were requested, so that a server which
+	// returns one of them as the next page again doesn't loop forever.
+	requested := map[string]bool{}
+	for {
+		editors := reqEditors
+		if next != nil {
+			nextURL := next
+			editors = append(editors[:len(editors):len(editors)], func(ctx context.Context, req *http.Request) error {
+				req.URL = nextURL
+				req.Host = nextURL.Host
+				return nil
+			})
+		}
+		rsp, err := c.ListOwnerThingsWithResponse(ctx, owner, editors...)
+		if err != nil {
+			return err
+		}
+		if rsp.StatusCode() != http.StatusOK {
+			return fmt.Errorf("unexpected status code %d requesting a page of ListOwnerThings", rsp.StatusCode())
+		}
+
+		var items []Thing
+		if value, err := paginationValue(rsp.Body, []string(nil)); err != nil {
+			return fmt.Errorf("error decoding the items of ListOwnerThings: %w", err)
+		} else if value != nil {
+			if err := json.Unmarshal(value, &items); err != nil {
+				return fmt.Errorf("error decoding the items of ListOwnerThings: %w", err)
+			}
+		}
+		for _, item := range items {
+			if !fn(item) {
+				return nil
+			}
+		}
+
+		next = nextPageLink(rsp.HTTPResponse)
+		if next == nil || requested[next.String()] {
+			return nil
+		}
+		requested[next.String()] = true
+	}
+}
+
+// ListThingsAll requests every page of ListThings, starting with the one selected
+// by params, and calls fn with each of their items in turn, until fn returns
+// false.
+func (c *ClientWithResponses) ListThingsAll(ctx context.Context, params *ListThingsParams, fn func(item Thing) bool, reqEditors ...RequestEditorFn) error {
+	return c.walkListThingsPages(ctx, params, reqEditors, fn)
+}
+
+// walkListThingsPages requests each page of ListThings in turn, calling fn with
+// their items until it returns false.
+func (c *ClientWithResponses) walkListThingsPages(ctx context.Context, params *ListThingsParams, reqEditors []RequestEditorFn, fn func(item Thing) bool) error {
+	var pageParams ListThingsParams
+	if params != nil {
+		pageParams = *params
+	}
+	// requested holds the pages which were requested, so that a server which
+	// returns one of them as the next page again doesn't loop forever.
+	requested := map[string]bool{}
+	for {
+		rsp, err := c.ListThingsWithResponse(ctx, &pageParams, reqEditors...)
+		if err != nil {
+			return err
+		}
+		if rsp.StatusCode() != http.StatusOK {
+			return fmt.Errorf("unexpected status code %d requesting a page of ListThings", rsp.StatusCode())
+		}
+
+		var items []Thing
+		if value, err := paginationValue(rsp.Body, []string{"data"}); err != nil {
+			return fmt.Errorf("error decoding the items of ListThings: %w", err)
+		} else if value != nil {
+			if err := json.Unmarshal(value, &items); err != nil {
+				return fmt.Errorf("error decoding the items of ListThings: %w", err)
+			}
+		}
+		for _, item := range items {
+			if !fn(item) {
+				return nil
+			}
+		}
+
+		value, err := paginationValue(rsp.Body, []string{"meta", "next_cursor"})
+		if err != nil {
+			return fmt.Errorf("error decoding the next cursor of ListThings: %w", err)
+		}
+		if value == nil || string(value) == `""` || requested[string(value)] {
+			return nil
+		}
+		requested[string(value)] = true
+		var cursor string
+		if err := json.Unmarshal(value, &cursor); err != nil {
+			return fmt.Errorf("error decoding the next cursor of ListThings: %w", err)
+		}
+		pageParams.Cursor = &cursor
+	}
+}
+
+// paginationValue returns the JSON value at the path of keys within the body,
+// or nil if it's missing or null.
+func paginationValue(body []byte, path []string) (json.RawMessage, error) {
+	value := json.RawMessage(body)
+	for _, key := range path {
+		var object map[string]json.RawMessage
+		if err := json.Unmarshal(value, &object); err != nil {
+			return nil, err
+		}
+		if value = object[key]; value == nil {
+			return nil, nil
+		}
+	}
+	if string(value) == "null" {
+		return nil, nil
+	}
+	return value, nil
+}
+
+// nextPageLink returns the target of the link with the "next" relation in the
+// RFC 5988 Link header of the response, if any, resolved against the URL of
+// its request.
+func nextPageLink(rsp *http.Response) *url.URL {
+	for _, header := range rsp.Header.Values("Link") {
+		for header != "" {
+			start := strings.IndexByte(header, '<')
+			end := strings.IndexByte(header, '>')
+			if start < 0 || end < start {
+				break
+			}
+			target, params := header[start+1:end], header[end+1:]
+			header = ""
+			if i := strings.IndexByte(params, '<'); i >= 0 {
+				params, header = params[:i], params[i:]
+			}
+
+			for _, param := range strings.Split(strings.TrimRight(strings.TrimSpace(params), ","), ";") {
+				key, value, _ := strings.Cut(strings.TrimSpace(param), "=")
+				if !strings.EqualFold(strings.TrimSpace(key), "rel") {
+					continue
+				}
+				for _, rel := range strings.Fields(strings.Trim(strings.TrimSpace(value), `"`)) {
+					if !strings.EqualFold(rel, "next") {
+						continue
+					}
+					base := &url.URL{}
+					if rsp.Request != nil && rsp.Request.URL != nil {
+						base = rsp.Request.URL
+					}
+					// A link back to the same page would never end
+					next, err := base.Parse(target)
+					if err != nil || next.String() == base.String() {
+						return nil
+					}
+					return next
+				}
+			}
+		}
+	}
+	return nil
+}
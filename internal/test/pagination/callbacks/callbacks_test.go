@@ -0,0 +1,45 @@
+package callbacks
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCallbackPagination(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page := map[string]interface{}{"data": []Thing{{Name: "a"}, {Name: "b"}}}
+		if r.URL.Query().Get("cursor") == "" {
+			page["meta"] = map[string]string{"next_cursor": "next"}
+		} else {
+			page["data"] = []Thing{{Name: "c"}}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(page)
+	}))
+	defer ts.Close()
+
+	client, err := NewClientWithResponses(ts.URL)
+	require.NoError(t, err)
+
+	var got []Thing
+	err = client.ListThingsAll(context.Background(), nil, func(thing Thing) bool {
+		got = append(got, thing)
+		return true
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []Thing{{Name: "a"}, {Name: "b"}, {Name: "c"}}, got)
+
+	got = nil
+	err = client.ListThingsAll(context.Background(), nil, func(thing Thing) bool {
+		got = append(got, thing)
+		return false
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []Thing{{Name: "a"}}, got)
+}
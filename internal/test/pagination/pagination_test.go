@@ -0,0 +1,161 @@
+//go:build go1.23
+
+package pagination
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var things = []Thing{{Name: "a"}, {Name: "b"}, {Name: "c"}, {Name: "d"}, {Name: "e"}}
+
+// newServer serves things in pages of two, selected either by a cursor, which
+// is the index of the first thing of the page, or by the page number in the
+// path of the Link header.
+func newServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/things", func(w http.ResponseWriter, r *http.Request) {
+		start := 0
+		if cursor := r.URL.Query().Get("cursor"); cursor != "" {
+			_, _ = fmt.Sscan(cursor, &start)
+		}
+		end := min(start+2, len(things))
+		page := map[string]interface{}{"data": things[start:end]}
+		if end < len(things) {
+			page["meta"] = map[string]string{"next_cursor": fmt.Sprint(end)}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(page)
+	})
+	mux.HandleFunc("/owners/", func(w http.ResponseWriter, r *http.Request) {
+		start := 0
+		if page := r.URL.Query().Get("page"); page != "" {
+			_, _ = fmt.Sscan(page, &start)
+		}
+		end := min(start+2, len(things))
+		if end < len(things) {
+			w.Header().Add("Link", fmt.Sprintf(`<%s?page=0>; rel="first", <%s?page=%d>; rel="next"`, r.URL.Path, r.URL.Path, end))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(things[start:end])
+	})
+	ts := httptest.NewServer(mux)
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func TestCursorPagination(t *testing.T) {
+	client, err := NewClientWithResponses(newServer(t).URL)
+	require.NoError(t, err)
+
+	var got []Thing
+	for thing, err := range client.ListThingsAll(context.Background(), &ListThingsParams{}) {
+		require.NoError(t, err)
+		got = append(got, thing)
+	}
+	assert.Equal(t, things, got)
+
+	// Iteration starts at the page selected by the params
+	cursor := "3"
+	got = nil
+	for thing, err := range client.ListThingsAll(context.Background(), &ListThingsParams{Cursor: &cursor}) {
+		require.NoError(t, err)
+		got = append(got, thing)
+	}
+	assert.Equal(t, things[3:], got)
+}
+
+func TestLinkHeaderPagination(t *testing.T) {
+	client, err := NewClientWithResponses(newServer(t).URL)
+	require.NoError(t, err)
+
+	var got []Thing
+	for thing, err := range client.ListOwnerThingsAll(context.Background(), "me") {
+		require.NoError(t, err)
+		got = append(got, thing)
+	}
+	assert.Equal(t, things, got)
+}
+
+func TestPaginationBreak(t *testing.T) {
+	requests := 0
+	client, err := NewClientWithResponses(newServer(t).URL, WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+		requests++
+		return nil
+	}))
+	require.NoError(t, err)
+
+	var got []Thing
+	for thing, err := range client.ListThingsAll(context.Background(), nil) {
+		require.NoError(t, err)
+		got = append(got, thing)
+		if len(got) == 3 {
+			break
+		}
+	}
+	assert.Equal(t, things[:3], got)
+	assert.Equal(t, 2, requests)
+}
+
+func TestPaginationError(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer ts.Close()
+	client, err := NewClientWithResponses(ts.URL)
+	require.NoError(t, err)
+
+	var errs []error
+	for _, err := range client.ListThingsAll(context.Background(), nil) {
+		errs = append(errs, err)
+	}
+	require.Len(t, errs, 1)
+	assert.ErrorContains(t, errs[0], "unexpected status code 500")
+}
+
+func TestPaginationRepeatedPage(t *testing.T) {
+	requests := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/things" {
+			// The cursor never advances past the second page
+			_, _ = fmt.Fprint(w, `{"data": [{"name": "a"}], "meta": {"next_cursor": "1"}}`)
+			return
+		}
+		// The links cycle between pages 1 and 2
+		next := "1"
+		if r.URL.Query().Get("page") == "1" {
+			next = "2"
+		}
+		w.Header().Set("Link", fmt.Sprintf(`<%s?page=%s>; rel="next"`, r.URL.Path, next))
+		_, _ = fmt.Fprint(w, `[{"name": "a"}]`)
+	}))
+	defer ts.Close()
+	client, err := NewClientWithResponses(ts.URL)
+	require.NoError(t, err)
+
+	var got []Thing
+	for thing, err := range client.ListThingsAll(context.Background(), nil) {
+		require.NoError(t, err)
+		got = append(got, thing)
+	}
+	assert.Len(t, got, 2)
+	assert.Equal(t, 2, requests)
+
+	requests, got = 0, nil
+	for thing, err := range client.ListOwnerThingsAll(context.Background(), "me") {
+		require.NoError(t, err)
+		got = append(got, thing)
+	}
+	assert.Len(t, got, 3)
+	assert.Equal(t, 3, requests)
+}
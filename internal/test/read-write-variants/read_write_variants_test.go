@@ -0,0 +1,63 @@
+package readwritevariants
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var createdAt = time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+// server stores the owners it's sent, and responds with the pets numbered.
+type server struct {
+	passwords []string
+}
+
+func (s *server) AddOwner(ctx context.Context, request AddOwnerRequestObject) (AddOwnerResponseObject, error) {
+	response := AddOwner201JSONResponse{Name: request.Body.Name}
+	for i, pet := range request.Body.Pets {
+		s.passwords = append(s.passwords, pet.Password)
+		response.Pets = append(response.Pets, PetResponse{
+			Id:        i + 1,
+			Name:      pet.Name,
+			CreatedAt: createdAt,
+		})
+	}
+	return response, nil
+}
+
+func TestReadWriteVariants(t *testing.T) {
+	s := &server{}
+	ts := httptest.NewServer(Handler(NewStrictHandler(s, nil)))
+	defer ts.Close()
+
+	client, err := NewClientWithResponses(ts.URL)
+	require.NoError(t, err)
+
+	rsp, err := client.AddOwnerWithResponse(context.Background(), AddOwnerJSONRequestBody{
+		Name: "Alice",
+		Pets: []PetRequest{{Name: "Rex", Password: "secret"}},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, rsp.JSON201)
+
+	assert.Equal(t, []string{"secret"}, s.passwords)
+	assert.Equal(t, OwnerResponse{
+		Name: "Alice",
+		Pets: []PetResponse{{Id: 1, Name: "Rex", CreatedAt: createdAt}},
+	}, *rsp.JSON201)
+
+	// The writeOnly properties aren't part of the response
+	assert.JSONEq(t, `{"name": "Alice", "pets": [{"id": 1, "name": "Rex", "createdAt": "2024-01-02T03:04:05Z"}]}`, string(rsp.Body))
+}
+
+func TestRequestVariantOmitsReadOnlyProperties(t *testing.T) {
+	data, err := json.Marshal(PetRequest{Name: "Rex", Password: "secret"})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name": "Rex", "password": "secret"}`, string(data))
+}
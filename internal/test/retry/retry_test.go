@@ -0,0 +1,210 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// flakyServer fails the first failures requests with statusCode, recording
+// the bodies of all the requests it receives.
+type flakyServer struct {
+	failures   int
+	statusCode int
+	retryAfter string
+	bodies     []string
+}
+
+func (s *flakyServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	s.bodies = append(s.bodies, string(body))
+	if len(s.bodies) <= s.failures {
+		if s.retryAfter != "" {
+			w.Header().Set("Retry-After", s.retryAfter)
+		}
+		w.WriteHeader(s.statusCode)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func newClient(t *testing.T, s *flakyServer, policy RetryPolicy) *Client {
+	t.Helper()
+	ts := httptest.NewServer(s)
+	t.Cleanup(ts.Close)
+	policy.InitialBackoff = time.Millisecond
+	client, err := NewClient(ts.URL, WithRetryPolicy(policy))
+	require.NoError(t, err)
+	return client
+}
+
+func TestRetryIdempotentMethod(t *testing.T) {
+	s := &flakyServer{failures: 2, statusCode: http.StatusServiceUnavailable}
+	client := newClient(t, s, RetryPolicy{})
+
+	rsp, err := client.ListThings(context.Background())
+	require.NoError(t, err)
+	defer rsp.Body.Close()
+	assert.Equal(t, http.StatusOK, rsp.StatusCode)
+	assert.Len(t, s.bodies, 3)
+}
+
+func TestRetryMaxAttempts(t *testing.T) {
+	s := &flakyServer{failures: 5, statusCode: http.StatusBadGateway}
+	client := newClient(t, s, RetryPolicy{MaxAttempts: 2})
+
+	rsp, err := client.ListThings(context.Background())
+	require.NoError(t, err)
+	defer rsp.Body.Close()
+	assert.Equal(t, http.StatusBadGateway, rsp.StatusCode)
+	assert.Len(t, s.bodies, 2)
+}
+
+func TestRetryStatusCodes(t *testing.T) {
+	s := &flakyServer{failures: 1, statusCode: http.StatusInternalServerError}
+	client := newClient(t, s, RetryPolicy{})
+
+	rsp, err := client.ListThings(context.Background())
+	require.NoError(t, err)
+	defer rsp.Body.Close()
+	assert.Equal(t, http.StatusInternalServerError, rsp.StatusCode)
+	assert.Len(t, s.bodies, 1)
+
+	s = &flakyServer{failures: 1, statusCode: http.StatusInternalServerError}
+	client = newClient(t, s, RetryPolicy{RetryStatusCodes: []int{http.StatusInternalServerError}})
+
+	rsp, err = client.ListThings(context.Background())
+	require.NoError(t, err)
+	defer rsp.Body.Close()
+	assert.Equal(t, http.StatusOK, rsp.StatusCode)
+	assert.Len(t, s.bodies, 2)
+}
+
+func TestRetryAfter(t *testing.T) {
+	s := &flakyServer{failures: 1, statusCode: http.StatusTooManyRequests, retryAfter: "0"}
+	client := newClient(t, s, RetryPolicy{})
+
+	rsp, err := client.ListThings(context.Background())
+	require.NoError(t, err)
+	defer rsp.Body.Close()
+	assert.Equal(t, http.StatusOK, rsp.StatusCode)
+	assert.Len(t, s.bodies, 2)
+
+	// Responses asking for a longer delay than MaxBackoff are returned
+	s = &flakyServer{failures: 1, statusCode: http.StatusTooManyRequests, retryAfter: "3600"}
+	client = newClient(t, s, RetryPolicy{})
+
+	rsp, err = client.ListThings(context.Background())
+	require.NoError(t, err)
+	defer rsp.Body.Close()
+	assert.Equal(t, http.StatusTooManyRequests, rsp.StatusCode)
+	assert.Len(t, s.bodies, 1)
+}
+
+func TestRetryNonIdempotent(t *testing.T) {
+	s := &flakyServer{failures: 1, statusCode: http.StatusServiceUnavailable}
+	client := newClient(t, s, RetryPolicy{})
+
+	rsp, err := client.CreateThing(context.Background(), CreateThingJSONRequestBody{Name: "a"})
+	require.NoError(t, err)
+	defer rsp.Body.Close()
+	assert.Equal(t, http.StatusServiceUnavailable, rsp.StatusCode)
+	assert.Len(t, s.bodies, 1)
+
+	// x-idempotent: false stops PUTs from being retried
+	s = &flakyServer{failures: 1, statusCode: http.StatusServiceUnavailable}
+	client = newClient(t, s, RetryPolicy{})
+
+	rsp, err = client.ReplaceThing(context.Background(), "a")
+	require.NoError(t, err)
+	defer rsp.Body.Close()
+	assert.Equal(t, http.StatusServiceUnavailable, rsp.StatusCode)
+	assert.Len(t, s.bodies, 1)
+
+	s = &flakyServer{failures: 1, statusCode: http.StatusServiceUnavailable}
+	client = newClient(t, s, RetryPolicy{RetryNonIdempotent: true})
+
+	rsp, err = client.CreateThing(context.Background(), CreateThingJSONRequestBody{Name: "a"})
+	require.NoError(t, err)
+	defer rsp.Body.Close()
+	assert.Equal(t, http.StatusOK, rsp.StatusCode)
+	assert.Equal(t, []string{`{"name":"a"}`, `{"name":"a"}`}, s.bodies)
+}
+
+func TestRetryRewindsBody(t *testing.T) {
+	s := &flakyServer{failures: 2, statusCode: http.StatusServiceUnavailable}
+	client := newClient(t, s, RetryPolicy{})
+
+	// x-idempotent: true allows POSTs to be retried
+	rsp, err := client.SearchThings(context.Background(), SearchThingsJSONRequestBody{Name: "a"})
+	require.NoError(t, err)
+	defer rsp.Body.Close()
+	assert.Equal(t, http.StatusOK, rsp.StatusCode)
+	assert.Equal(t, []string{`{"name":"a"}`, `{"name":"a"}`, `{"name":"a"}`}, s.bodies)
+
+	// Bodies which can't be rewound are buffered
+	s = &flakyServer{failures: 1, statusCode: http.StatusServiceUnavailable}
+	client = newClient(t, s, RetryPolicy{})
+
+	body := io.MultiReader(strings.NewReader(`{"name":`), strings.NewReader(`"b"}`))
+	rsp, err = client.SearchThingsWithBody(context.Background(), "application/json", body)
+	require.NoError(t, err)
+	defer rsp.Body.Close()
+	assert.Equal(t, http.StatusOK, rsp.StatusCode)
+	assert.Equal(t, []string{`{"name":"b"}`, `{"name":"b"}`}, s.bodies)
+}
+
+// failingDoer fails the first failures requests without a response.
+type failingDoer struct {
+	failures int
+	attempts int
+}
+
+func (d *failingDoer) Do(req *http.Request) (*http.Response, error) {
+	d.attempts++
+	if d.attempts <= d.failures {
+		return nil, errors.New("connection reset")
+	}
+	return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+}
+
+func TestRetryNetworkErrors(t *testing.T) {
+	doer := &failingDoer{failures: 1}
+	client, err := NewClient("http://example.com", WithHTTPClient(doer), WithRetryPolicy(RetryPolicy{InitialBackoff: time.Millisecond}))
+	require.NoError(t, err)
+
+	rsp, err := client.ListThings(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rsp.StatusCode)
+	assert.Equal(t, 2, doer.attempts)
+
+	doer = &failingDoer{failures: 1}
+	client, err = NewClient("http://example.com", WithRetryPolicy(RetryPolicy{DisableNetworkErrorRetries: true}), WithHTTPClient(doer))
+	require.NoError(t, err)
+
+	_, err = client.ListThings(context.Background())
+	assert.Error(t, err)
+	assert.Equal(t, 1, doer.attempts)
+}
+
+func TestRetryContextCancelled(t *testing.T) {
+	s := &flakyServer{failures: 5, statusCode: http.StatusServiceUnavailable}
+	ts := httptest.NewServer(s)
+	defer ts.Close()
+	client, err := NewClient(ts.URL, WithRetryPolicy(RetryPolicy{InitialBackoff: time.Hour, MaxBackoff: time.Hour}))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = client.ListThings(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Len(t, s.bodies, 1)
+}
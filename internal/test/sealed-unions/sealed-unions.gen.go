@@ -0,0 +1,863 @@
+// Package sealedunions provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.0.0-00010101000000-000000000000 DO NOT EDIT.
+package sealedunions
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	strictnethttp "github.com/oapi-codegen/runtime/strictmiddleware/nethttp"
+)
+
+// Cat defines model for Cat.
+type Cat struct {
+	Lives   *int   `json:"lives,omitempty"`
+	Name    string `json:"name"`
+	PetType string `json:"petType"`
+}
+
+// Dog defines model for Dog.
+type Dog struct {
+	GoodBoy *bool  `json:"goodBoy,omitempty"`
+	Name    string `json:"name"`
+	PetType string `json:"petType"`
+}
+
+// Owner defines model for Owner.
+type Owner struct {
+	Favourite *Owner_Favourite `json:"favourite,omitempty"`
+	Pets      []Pet            `json:"pets"`
+}
+
+// Owner_Favourite defines model for Owner.Favourite.
+type Owner_Favourite struct {
+	Value Owner_FavouriteVariant
+}
+
+// Pet defines model for Pet.
+type Pet struct {
+	Value PetVariant
+}
+
+// AddPetJSONRequestBody defines body for AddPet for application/json ContentType.
+type AddPetJSONRequestBody = Pet
+
+// Owner_FavouriteVariant is implemented by each of the types Owner_Favourite may hold: Cat, Dog.
+type Owner_FavouriteVariant interface {
+	isOwner_FavouriteVariant()
+}
+
+func (Cat) isOwner_FavouriteVariant() {}
+
+func (Dog) isOwner_FavouriteVariant() {}
+
+// Owner_FavouriteVisitor handles each of the types Owner_Favourite may hold.
+type Owner_FavouriteVisitor interface {
+	VisitCat(Cat) error
+	VisitDog(Dog) error
+}
+
+// Visit calls the method of the visitor handling the type of the value the Owner_Favourite holds.
+func (t Owner_Favourite) Visit(visitor Owner_FavouriteVisitor) error {
+	switch v := t.Value.(type) {
+	case Cat:
+		return visitor.VisitCat(v)
+	case Dog:
+		return visitor.VisitDog(v)
+	}
+	return fmt.Errorf("Owner_Favourite holds a value of type %T, which isn't one of its variants", t.Value)
+}
+
+// Discriminator returns the value of the discriminator of the type of the value the Owner_Favourite holds.
+func (t Owner_Favourite) Discriminator() (string, error) {
+	switch t.Value.(type) {
+	case Cat:
+		return "Cat", nil
+	case Dog:
+		return "Dog", nil
+	}
+	return "", fmt.Errorf("Owner_Favourite holds a value of type %T, which isn't one of its variants", t.Value)
+}
+
+func (t Owner_Favourite) MarshalJSON() ([]byte, error) {
+	switch v := t.Value.(type) {
+	case Cat:
+		v.PetType = "Cat"
+		return json.Marshal(v)
+	case Dog:
+		v.PetType = "Dog"
+		return json.Marshal(v)
+	case nil:
+		return []byte("null"), nil
+	}
+	return nil, fmt.Errorf("Owner_Favourite holds a value of type %T, which isn't one of its variants", t.Value)
+}
+
+func (t *Owner_Favourite) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var discriminator struct {
+		Discriminator string `json:"petType"`
+	}
+	if err := json.Unmarshal(b, &discriminator); err != nil {
+		return err
+	}
+	switch discriminator.Discriminator {
+	case "Cat":
+		var v Cat
+		if err := json.Unmarshal(b, &v); err != nil {
+			return err
+		}
+		t.Value = v
+	case "Dog":
+		var v Dog
+		if err := json.Unmarshal(b, &v); err != nil {
+			return err
+		}
+		t.Value = v
+	default:
+		return errors.New("unknown discriminator value: " + discriminator.Discriminator)
+	}
+	return nil
+}
+
+// PetVariant is implemented by each of the types Pet may hold: Cat, Dog.
+type PetVariant interface {
+	isPetVariant()
+}
+
+func (Cat) isPetVariant() {}
+
+func (Dog) isPetVariant() {}
+
+// PetVisitor handles each of the types Pet may hold.
+type PetVisitor interface {
+	VisitCat(Cat) error
+	VisitDog(Dog) error
+}
+
+// Visit calls the method of the visitor handling the type of the value the Pet holds.
+func (t Pet) Visit(visitor PetVisitor) error {
+	switch v := t.Value.(type) {
+	case Cat:
+		return visitor.VisitCat(v)
+	case Dog:
+		return visitor.VisitDog(v)
+	}
+	return fmt.Errorf("Pet holds a value of type %T, which isn't one of its variants", t.Value)
+}
+
+// Discriminator returns the value of the discriminator of the type of the value the Pet holds.
+func (t Pet) Discriminator() (string, error) {
+	switch t.Value.(type) {
+	case Cat:
+		return "cat", nil
+	case Dog:
+		return "dog", nil
+	}
+	return "", fmt.Errorf("Pet holds a value of type %T, which isn't one of its variants", t.Value)
+}
+
+func (t Pet) MarshalJSON() ([]byte, error) {
+	switch v := t.Value.(type) {
+	case Cat:
+		v.PetType = "cat"
+		return json.Marshal(v)
+	case Dog:
+		v.PetType = "dog"
+		return json.Marshal(v)
+	case nil:
+		return []byte("null"), nil
+	}
+	return nil, fmt.Errorf("Pet holds a value of type %T, which isn't one of its variants", t.Value)
+}
+
+func (t *Pet) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var discriminator struct {
+		Discriminator string `json:"petType"`
+	}
+	if err := json.Unmarshal(b, &discriminator); err != nil {
+		return err
+	}
+	switch discriminator.Discriminator {
+	case "cat":
+		var v Cat
+		if err := json.Unmarshal(b, &v); err != nil {
+			return err
+		}
+		t.Value = v
+	case "dog":
+		var v Dog
+		if err := json.Unmarshal(b, &v); err != nil {
+			return err
+		}
+		t.Value = v
+	default:
+		return errors.New("unknown discriminator value: " + discriminator.Discriminator)
+	}
+	return nil
+}
+
+// ValidationError describes a value which doesn't satisfy a constraint of its
+// schema.
+type ValidationError struct {
+	// Path is the JSON pointer to the invalid value, relative to the value
+	// which was validated.
+	Path string
+	// Message describes the constraint which isn't satisfied.
+	Message string
+}
+
+func (e ValidationError) Error() string {
+	if e.Path == "" {
+		return e.Message
+	}
+	return e.Path + ": " + e.Message
+}
+
+// ValidationErrors is returned by Validate, and contains all the constraint
+// violations which were found.
+type ValidationErrors []ValidationError
+
+func (e ValidationErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+func (e *ValidationErrors) add(path string, message string) {
+	*e = append(*e, ValidationError{Path: path, Message: message})
+}
+
+// addNested adds the error returned by validating the value at path.
+func (e *ValidationErrors) addNested(path string, err error) {
+	if err == nil {
+		return
+	}
+	var nested ValidationErrors
+	if errors.As(err, &nested) {
+		for _, n := range nested {
+			e.add(path+n.Path, n.Message)
+		}
+		return
+	}
+	e.add(path, err.Error())
+}
+
+// orNil returns the errors, or nil when there aren't any.
+func (e ValidationErrors) orNil() error {
+	if len(e) == 0 {
+		return nil
+	}
+	return e
+}
+
+// validationPointerToken escapes a key for use in a JSON pointer.
+func validationPointerToken(key string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(key)
+}
+
+// validationHasDuplicates returns whether the slice items contains any equal
+// items.
+func validationHasDuplicates(items interface{}) bool {
+	v := reflect.ValueOf(items)
+	for i := 1; i < v.Len(); i++ {
+		for j := 0; j < i; j++ {
+			if reflect.DeepEqual(v.Index(i).Interface(), v.Index(j).Interface()) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// validationIsMultipleOf returns whether v is a multiple of m. The quotient is
+// compared to the nearest integer relative to its magnitude, as dividing by
+// fractions which can't be represented exactly, such as 0.1, doesn't give an
+// exact integer.
+func validationIsMultipleOf(v, m float64) bool {
+	q := v / m
+	return math.Abs(q-math.Round(q)) <= 1e-12*math.Max(1, math.Abs(q))
+}
+
+// validationFloat32 converts v to the float64 with the same shortest decimal
+// representation, rather than the float64 closest to its binary value.
+func validationFloat32(v float32) float64 {
+	f, _ := strconv.ParseFloat(strconv.FormatFloat(float64(v), 'g', -1, 32), 64)
+	return f
+}
+
+// Validate checks that the value satisfies the constraints of its schema,
+// returning all the violations found as ValidationErrors.
+func (t Cat) Validate() error {
+	var errs ValidationErrors
+	if t.Lives != nil {
+		if float64(*t.Lives) > 9 {
+			errs.add("/lives", "must be <= 9")
+		}
+	}
+
+	return errs.orNil()
+}
+
+// Validate checks that the value satisfies the constraints of its schema,
+// returning all the violations found as ValidationErrors.
+func (t Dog) Validate() error {
+	var errs ValidationErrors
+
+	return errs.orNil()
+}
+
+// Validate checks that the value satisfies the constraints of its schema,
+// returning all the violations found as ValidationErrors.
+func (t Owner) Validate() error {
+	var errs ValidationErrors
+	if t.Favourite != nil {
+		errs.addNested("/favourite", t.Favourite.Validate())
+	}
+	for i0, v1 := range t.Pets {
+		errs.addNested("/pets/"+strconv.Itoa(i0), v1.Validate())
+	}
+
+	return errs.orNil()
+}
+
+// Validate checks that the value satisfies the constraints of its schema,
+// returning all the violations found as ValidationErrors.
+func (t Owner_Favourite) Validate() error {
+	var errs ValidationErrors
+	if v, ok := t.Value.(interface{ Validate() error }); ok {
+		errs.addNested("", v.Validate())
+	}
+
+	return errs.orNil()
+}
+
+// Validate checks that the value satisfies the constraints of its schema,
+// returning all the violations found as ValidationErrors.
+func (t Pet) Validate() error {
+	var errs ValidationErrors
+	if v, ok := t.Value.(interface{ Validate() error }); ok {
+		errs.addNested("", v.Validate())
+	}
+
+	return errs.orNil()
+}
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// AddPetWithBody request with any body
+	AddPetWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AddPet(ctx context.Context, body AddPetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) AddPetWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddPetRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AddPet(ctx context.Context, body AddPetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddPetRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewAddPetRequest calls the generic AddPet builder with application/json body
+func NewAddPetRequest(server string, body AddPetJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddPetRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAddPetRequestWithBody generates requests for AddPet with any type of body
+func NewAddPetRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pets")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// AddPetWithBodyWithResponse request with any body
+	AddPetWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddPetResponse, error)
+
+	AddPetWithResponse(ctx context.Context, body AddPetJSONRequestBody, reqEditors ...RequestEditorFn) (*AddPetResponse, error)
+}
+
+type AddPetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Pet
+}
+
+// Status returns HTTPResponse.Status
+func (r AddPetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddPetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// AddPetWithBodyWithResponse request with arbitrary body returning *AddPetResponse
+func (c *ClientWithResponses) AddPetWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddPetResponse, error) {
+	rsp, err := c.AddPetWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddPetResponse(rsp)
+}
+
+func (c *ClientWithResponses) AddPetWithResponse(ctx context.Context, body AddPetJSONRequestBody, reqEditors ...RequestEditorFn) (*AddPetResponse, error) {
+	rsp, err := c.AddPet(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddPetResponse(rsp)
+}
+
+// ParseAddPetResponse parses an HTTP response from a AddPetWithResponse call
+func ParseAddPetResponse(rsp *http.Response) (*AddPetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AddPetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Pet
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+
+	// (POST /pets)
+	AddPet(w http.ResponseWriter, r *http.Request)
+}
+
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+
+type Unimplemented struct{}
+
+// (POST /pets)
+func (_ Unimplemented) AddPet(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// AddPet operation middleware
+func (siw *ServerInterfaceWrapper) AddPet(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddPet(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pets", wrapper.AddPet)
+	})
+
+	return r
+}
+
+type AddPetRequestObject struct {
+	Body *AddPetJSONRequestBody
+}
+
+type AddPetResponseObject interface {
+	VisitAddPetResponse(w http.ResponseWriter) error
+}
+
+type AddPet201JSONResponse Pet
+
+func (response AddPet201JSONResponse) VisitAddPetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(Pet(response))
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+
+	// (POST /pets)
+	AddPet(ctx context.Context, request AddPetRequestObject) (AddPetResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// AddPet operation middleware
+func (sh *strictHandler) AddPet(w http.ResponseWriter, r *http.Request) {
+	var request AddPetRequestObject
+
+	var body AddPetJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AddPet(ctx, request.(AddPetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AddPet")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AddPetResponseObject); ok {
+		if err := validResponse.VisitAddPetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
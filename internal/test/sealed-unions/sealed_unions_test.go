@@ -0,0 +1,106 @@
+package sealedunions
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// describer describes each of the types a Pet may hold.
+type describer struct {
+	description string
+}
+
+func (d *describer) VisitCat(cat Cat) error {
+	d.description = fmt.Sprintf("%s the cat", cat.Name)
+	return nil
+}
+
+func (d *describer) VisitDog(dog Dog) error {
+	d.description = fmt.Sprintf("%s the dog", dog.Name)
+	return nil
+}
+
+// server responds with the pets it's sent.
+type server struct {
+	added []string
+}
+
+func (s *server) AddPet(ctx context.Context, request AddPetRequestObject) (AddPetResponseObject, error) {
+	d := &describer{}
+	if err := request.Body.Visit(d); err != nil {
+		return nil, err
+	}
+	s.added = append(s.added, d.description)
+	return AddPet201JSONResponse(*request.Body), nil
+}
+
+func TestSealedUnion(t *testing.T) {
+	s := &server{}
+	ts := httptest.NewServer(Handler(NewStrictHandler(s, nil)))
+	defer ts.Close()
+
+	client, err := NewClientWithResponses(ts.URL)
+	require.NoError(t, err)
+
+	rsp, err := client.AddPetWithResponse(context.Background(), AddPetJSONRequestBody{Value: Dog{Name: "Rex"}})
+	require.NoError(t, err)
+	require.NotNil(t, rsp.JSON201)
+
+	assert.Equal(t, []string{"Rex the dog"}, s.added)
+	// The discriminator is set when marshaling
+	assert.Equal(t, Pet{Value: Dog{PetType: "dog", Name: "Rex"}}, *rsp.JSON201)
+	assert.JSONEq(t, `{"petType": "dog", "name": "Rex"}`, string(rsp.Body))
+}
+
+func TestSealedUnionUnmarshal(t *testing.T) {
+	var owner Owner
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"pets": [{"petType": "cat", "name": "Tom", "lives": 9}, {"petType": "dog", "name": "Rex"}],
+		"favourite": {"petType": "Cat", "name": "Tom"}
+	}`), &owner))
+
+	lives := 9
+	assert.Equal(t, []Pet{
+		{Value: Cat{PetType: "cat", Name: "Tom", Lives: &lives}},
+		{Value: Dog{PetType: "dog", Name: "Rex"}},
+	}, owner.Pets)
+	assert.Equal(t, &Owner_Favourite{Value: Cat{PetType: "Cat", Name: "Tom"}}, owner.Favourite)
+
+	discriminator, err := owner.Pets[1].Discriminator()
+	require.NoError(t, err)
+	assert.Equal(t, "dog", discriminator)
+
+	var pet Pet
+	err = json.Unmarshal([]byte(`{"petType": "fish", "name": "Nemo"}`), &pet)
+	assert.EqualError(t, err, "unknown discriminator value: fish")
+}
+
+func TestSealedUnionMarshal(t *testing.T) {
+	data, err := json.Marshal(Owner{Pets: []Pet{{Value: Cat{Name: "Tom"}}}, Favourite: &Owner_Favourite{Value: Dog{Name: "Rex"}}})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"pets": [{"petType": "cat", "name": "Tom"}], "favourite": {"petType": "Dog", "name": "Rex"}}`, string(data))
+
+	data, err = json.Marshal(Pet{})
+	require.NoError(t, err)
+	assert.Equal(t, "null", string(data))
+}
+
+func TestSealedUnionVisit(t *testing.T) {
+	d := &describer{}
+	require.NoError(t, Pet{Value: Cat{Name: "Tom"}}.Visit(d))
+	assert.Equal(t, "Tom the cat", d.description)
+
+	assert.EqualError(t, Pet{}.Visit(d), "Pet holds a value of type <nil>, which isn't one of its variants")
+}
+
+func TestSealedUnionValidate(t *testing.T) {
+	lives := 10
+	err := Owner{Pets: []Pet{{Value: Cat{Name: "Tom", Lives: &lives}}}}.Validate()
+	assert.EqualError(t, err, "/pets/0/lives: must be <= 9")
+}
@@ -0,0 +1,519 @@
+// Package securityhandler provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.0.0-00010101000000-000000000000 DO NOT EDIT.
+package securityhandler
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/oapi-codegen/runtime"
+)
+
+const (
+	ApiKeyAuthScopes  = "ApiKeyAuth.Scopes"
+	BasicAuthScopes   = "BasicAuth.Scopes"
+	BearerAuthScopes  = "BearerAuth.Scopes"
+	SessionAuthScopes = "SessionAuth.Scopes"
+)
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	SecurityHandler
+
+	// (GET /greeting)
+	GetGreeting(w http.ResponseWriter, r *http.Request)
+
+	// (GET /me)
+	GetMe(w http.ResponseWriter, r *http.Request)
+
+	// (GET /public)
+	GetPublic(w http.ResponseWriter, r *http.Request)
+
+	// (GET /things/{id})
+	GetThing(w http.ResponseWriter, r *http.Request, id string)
+}
+
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+
+type Unimplemented struct{}
+
+// (GET /greeting)
+func (_ Unimplemented) GetGreeting(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /me)
+func (_ Unimplemented) GetMe(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /public)
+func (_ Unimplemented) GetPublic(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /things/{id})
+func (_ Unimplemented) GetThing(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// GetGreeting operation middleware
+func (siw *ServerInterfaceWrapper) GetGreeting(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	authCtx, err := authenticate(ctx, siw.Handler, httpSecurityCredentials{r}, securityRequirements["GetGreeting"])
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, err)
+		return
+	}
+	ctx = authCtx
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetGreeting(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetMe operation middleware
+func (siw *ServerInterfaceWrapper) GetMe(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	authCtx, err := authenticate(ctx, siw.Handler, httpSecurityCredentials{r}, securityRequirements["GetMe"])
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, err)
+		return
+	}
+	ctx = authCtx
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMe(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPublic operation middleware
+func (siw *ServerInterfaceWrapper) GetPublic(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPublic(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetThing operation middleware
+func (siw *ServerInterfaceWrapper) GetThing(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"things:read"})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	authCtx, err := authenticate(ctx, siw.Handler, httpSecurityCredentials{r}, securityRequirements["GetThing"])
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, err)
+		return
+	}
+	ctx = authCtx
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetThing(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			var securityErr *SecurityError
+			if errors.As(err, &securityErr) {
+				http.Error(w, err.Error(), http.StatusUnauthorized)
+				return
+			}
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/greeting", wrapper.GetGreeting)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/me", wrapper.GetMe)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/public", wrapper.GetPublic)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/things/{id}", wrapper.GetThing)
+	})
+
+	return r
+}
+
+// SecurityHandler authenticates the requests of the operations with security
+// requirements, before they're handled. It has a method for each of the
+// security schemes of the spec, which is called with the credentials of the
+// request for the scheme, and the scopes the operation requires of it. It
+// returns the context the request is handled with, such as to carry the
+// authenticated principal, or an error if the credentials aren't valid.
+type SecurityHandler interface {
+	// HandleApiKeyAuth authenticates the ApiKeyAuth security scheme.
+	HandleApiKeyAuth(ctx context.Context, key string, scopes []string) (context.Context, error)
+	// HandleBasicAuth authenticates the BasicAuth security scheme.
+	HandleBasicAuth(ctx context.Context, username, password string, scopes []string) (context.Context, error)
+	// HandleBearerAuth authenticates the BearerAuth security scheme.
+	HandleBearerAuth(ctx context.Context, token string, scopes []string) (context.Context, error)
+	// HandleSessionAuth authenticates the SessionAuth security scheme.
+	HandleSessionAuth(ctx context.Context, key string, scopes []string) (context.Context, error)
+}
+
+// securityScheme is a security scheme required by a security requirement,
+// with the scopes it requires.
+type securityScheme struct {
+	name   string
+	scopes []string
+}
+
+// securityRequirements are the alternative security requirements of each of
+// the operations which have any, one of which must be met by its requests, by
+// meeting each of its schemes.
+var securityRequirements = map[string][][]securityScheme{
+	"GetGreeting": {
+		{},
+		{{"BearerAuth", []string{}}},
+	},
+	"GetMe": {
+		{{"SessionAuth", []string{}}},
+	},
+	"GetThing": {
+		{{"BearerAuth", []string{"things:read"}}},
+		{{"ApiKeyAuth", []string{}}, {"BasicAuth", []string{}}},
+	},
+}
+
+// securityCredentials reads the credentials of a request.
+type securityCredentials interface {
+	Header(name string) string
+	Query(name string) string
+	Cookie(name string) string
+}
+
+// httpSecurityCredentials reads the credentials of an *http.Request.
+type httpSecurityCredentials struct {
+	r *http.Request
+}
+
+func (c httpSecurityCredentials) Header(name string) string {
+	return c.r.Header.Get(name)
+}
+
+func (c httpSecurityCredentials) Query(name string) string {
+	return c.r.URL.Query().Get(name)
+}
+
+func (c httpSecurityCredentials) Cookie(name string) string {
+	cookie, err := c.r.Cookie(name)
+	if err != nil {
+		return ""
+	}
+	return cookie.Value
+}
+
+// authenticate authenticates a request with credentials against the
+// requirements, which are tried in order until one of them is met, returning
+// the context returned by the SecurityHandler for each of its schemes. A
+// requirement without any schemes is met by any request, so it's only tried
+// once none of the others are met, for the requests with credentials to still
+// be authenticated.
+func authenticate(ctx context.Context, sh SecurityHandler, creds securityCredentials, requirements [][]securityScheme) (context.Context, error) {
+	var errs []error
+	anonymous := false
+	for _, requirement := range requirements {
+		if len(requirement) == 0 {
+			anonymous = true
+			continue
+		}
+		authCtx := ctx
+		var err error
+		for _, scheme := range requirement {
+			authCtx, err = authenticateScheme(authCtx, sh, creds, scheme)
+			if err != nil {
+				break
+			}
+		}
+		if err == nil {
+			return authCtx, nil
+		}
+		errs = append(errs, err)
+	}
+	if anonymous {
+		return ctx, nil
+	}
+	return ctx, &SecurityError{Errs: errs}
+}
+
+// authenticateScheme authenticates a request with credentials for the scheme.
+func authenticateScheme(ctx context.Context, sh SecurityHandler, creds securityCredentials, scheme securityScheme) (context.Context, error) {
+	switch scheme.name {
+	case "ApiKeyAuth":
+		key := creds.Header("X-API-Key")
+		if key == "" {
+			return ctx, &MissingCredentialsError{Scheme: scheme.name}
+		}
+		return sh.HandleApiKeyAuth(ctx, key, scheme.scopes)
+	case "BasicAuth":
+		credentials, ok := securityAuthorization(creds.Header("Authorization"), "Basic")
+		if !ok {
+			return ctx, &MissingCredentialsError{Scheme: scheme.name}
+		}
+		username, password, ok := parseBasicCredentials(credentials)
+		if !ok {
+			return ctx, fmt.Errorf("malformed credentials for security scheme %s", scheme.name)
+		}
+		return sh.HandleBasicAuth(ctx, username, password, scheme.scopes)
+	case "BearerAuth":
+		credentials, ok := securityAuthorization(creds.Header("Authorization"), "Bearer")
+		if !ok {
+			return ctx, &MissingCredentialsError{Scheme: scheme.name}
+		}
+		return sh.HandleBearerAuth(ctx, credentials, scheme.scopes)
+	case "SessionAuth":
+		key := creds.Cookie("session")
+		if key == "" {
+			return ctx, &MissingCredentialsError{Scheme: scheme.name}
+		}
+		return sh.HandleSessionAuth(ctx, key, scheme.scopes)
+	}
+	return ctx, fmt.Errorf("unknown security scheme %s", scheme.name)
+}
+
+// securityAuthorization returns the credentials of the Authorization header,
+// if it's of the authorization scheme, which is matched case-insensitively.
+func securityAuthorization(header, scheme string) (string, bool) {
+	prefix, credentials, found := strings.Cut(header, " ")
+	if !found || !strings.EqualFold(prefix, scheme) {
+		return "", false
+	}
+	credentials = strings.TrimSpace(credentials)
+	return credentials, credentials != ""
+}
+
+// parseBasicCredentials returns the username and password of the credentials
+// of HTTP basic authentication.
+func parseBasicCredentials(credentials string) (string, string, bool) {
+	decoded, err := base64.StdEncoding.DecodeString(credentials)
+	if err != nil {
+		return "", "", false
+	}
+	return strings.Cut(string(decoded), ":")
+}
+
+// SecurityError is the error of a request which meets none of the security
+// requirements of its operation, holding why each of them isn't met.
+type SecurityError struct {
+	Errs []error
+}
+
+func (e *SecurityError) Error() string {
+	if len(e.Errs) == 0 {
+		return "Security requirements not met"
+	}
+	return fmt.Sprintf("Security requirements not met: %s", errors.Join(e.Errs...))
+}
+
+func (e *SecurityError) Unwrap() []error {
+	return e.Errs
+}
+
+// MissingCredentialsError is the error of a request without credentials for
+// a security scheme.
+type MissingCredentialsError struct {
+	Scheme string
+}
+
+func (e *MissingCredentialsError) Error() string {
+	return fmt.Sprintf("Missing credentials for security scheme %s", e.Scheme)
+}
+
+// HandleApiKeyAuth rejects any credentials of the ApiKeyAuth security scheme.
+func (_ Unimplemented) HandleApiKeyAuth(ctx context.Context, key string, scopes []string) (context.Context, error) {
+	return ctx, errors.New("security scheme ApiKeyAuth is not implemented")
+}
+
+// HandleBasicAuth rejects any credentials of the BasicAuth security scheme.
+func (_ Unimplemented) HandleBasicAuth(ctx context.Context, username, password string, scopes []string) (context.Context, error) {
+	return ctx, errors.New("security scheme BasicAuth is not implemented")
+}
+
+// HandleBearerAuth rejects any credentials of the BearerAuth security scheme.
+func (_ Unimplemented) HandleBearerAuth(ctx context.Context, token string, scopes []string) (context.Context, error) {
+	return ctx, errors.New("security scheme BearerAuth is not implemented")
+}
+
+// HandleSessionAuth rejects any credentials of the SessionAuth security scheme.
+func (_ Unimplemented) HandleSessionAuth(ctx context.Context, key string, scopes []string) (context.Context, error) {
+	return ctx, errors.New("security scheme SessionAuth is not implemented")
+}
@@ -0,0 +1,213 @@
+package securityhandler
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type principalKey struct{}
+
+// server authenticates the credentials "good" of each of the schemes, and
+// responds with the principals they're authenticated as.
+type server struct {
+	bearerScopes []string
+}
+
+var _ ServerInterface = (*server)(nil)
+
+func authenticated(ctx context.Context, principal string) context.Context {
+	if prev, ok := ctx.Value(principalKey{}).(string); ok {
+		principal = prev + "+" + principal
+	}
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+func (s *server) HandleApiKeyAuth(ctx context.Context, key string, scopes []string) (context.Context, error) {
+	if key != "good" {
+		return ctx, errors.New("bad key")
+	}
+	return authenticated(ctx, "key"), nil
+}
+
+func (s *server) HandleBasicAuth(ctx context.Context, username, password string, scopes []string) (context.Context, error) {
+	if password != "good" {
+		return ctx, errors.New("bad password")
+	}
+	return authenticated(ctx, username), nil
+}
+
+func (s *server) HandleBearerAuth(ctx context.Context, token string, scopes []string) (context.Context, error) {
+	s.bearerScopes = scopes
+	if token != "good" {
+		return ctx, errors.New("bad token")
+	}
+	return authenticated(ctx, "bearer"), nil
+}
+
+func (s *server) HandleSessionAuth(ctx context.Context, key string, scopes []string) (context.Context, error) {
+	if key != "good" {
+		return ctx, errors.New("bad session")
+	}
+	return authenticated(ctx, "session"), nil
+}
+
+func (s *server) GetMe(w http.ResponseWriter, r *http.Request) {
+	_, _ = io.WriteString(w, r.Context().Value(principalKey{}).(string))
+}
+
+func (s *server) GetGreeting(w http.ResponseWriter, r *http.Request) {
+	principal, ok := r.Context().Value(principalKey{}).(string)
+	if !ok {
+		principal = "anonymous"
+	}
+	_, _ = io.WriteString(w, "hello "+principal)
+}
+
+func (s *server) GetPublic(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *server) GetThing(w http.ResponseWriter, r *http.Request, id string) {
+	_, _ = io.WriteString(w, r.Context().Value(principalKey{}).(string))
+}
+
+func do(t *testing.T, handler http.Handler, path string, edit func(*http.Request)) (int, string) {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	if edit != nil {
+		edit(req)
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	body, err := io.ReadAll(rec.Result().Body)
+	require.NoError(t, err)
+	return rec.Code, string(body)
+}
+
+func TestPublicOperation(t *testing.T) {
+	handler := HandlerFromMux(&server{}, chi.NewRouter())
+
+	code, _ := do(t, handler, "/public", nil)
+	assert.Equal(t, http.StatusNoContent, code)
+}
+
+func TestGlobalSecurityRequirement(t *testing.T) {
+	handler := HandlerFromMux(&server{}, chi.NewRouter())
+
+	code, body := do(t, handler, "/me", func(r *http.Request) {
+		r.AddCookie(&http.Cookie{Name: "session", Value: "good"})
+	})
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "session", body)
+
+	code, body = do(t, handler, "/me", nil)
+	assert.Equal(t, http.StatusUnauthorized, code)
+	assert.Contains(t, body, "Missing credentials for security scheme SessionAuth")
+}
+
+func TestAlternativeSecurityRequirements(t *testing.T) {
+	s := &server{}
+	handler := HandlerFromMux(s, chi.NewRouter())
+
+	code, body := do(t, handler, "/things/1", func(r *http.Request) {
+		r.Header.Set("Authorization", "Bearer good")
+	})
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "bearer", body)
+	assert.Equal(t, []string{"things:read"}, s.bearerScopes)
+
+	code, body = do(t, handler, "/things/1", func(r *http.Request) {
+		r.Header.Set("X-API-Key", "good")
+		r.SetBasicAuth("alice", "good")
+	})
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "key+alice", body)
+}
+
+func TestOptionalSecurityRequirement(t *testing.T) {
+	handler := HandlerFromMux(&server{}, chi.NewRouter())
+
+	// The request is authenticated by the requirement it meets, even when the
+	// anonymous requirement precedes it
+	code, body := do(t, handler, "/greeting", func(r *http.Request) {
+		r.Header.Set("Authorization", "Bearer good")
+	})
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "hello bearer", body)
+
+	code, body = do(t, handler, "/greeting", nil)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "hello anonymous", body)
+}
+
+func TestSecurityRequirementNotMet(t *testing.T) {
+	handler := HandlerFromMux(&server{}, chi.NewRouter())
+
+	tests := []struct {
+		name string
+		edit func(*http.Request)
+	}{
+		{
+			name: "no credentials",
+		},
+		{
+			name: "bad token",
+			edit: func(r *http.Request) {
+				r.Header.Set("Authorization", "Bearer bad")
+			},
+		},
+		{
+			name: "only one scheme of a requirement",
+			edit: func(r *http.Request) {
+				r.Header.Set("X-API-Key", "good")
+			},
+		},
+		{
+			name: "bad password",
+			edit: func(r *http.Request) {
+				r.Header.Set("X-API-Key", "good")
+				r.SetBasicAuth("alice", "bad")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, body := do(t, handler, "/things/1", tt.edit)
+			assert.Equal(t, http.StatusUnauthorized, code)
+			assert.Contains(t, body, "Security requirements not met")
+		})
+	}
+}
+
+func TestSecurityErrorHandler(t *testing.T) {
+	var handled error
+	handler := HandlerWithOptions(&server{}, ChiServerOptions{
+		BaseRouter: chi.NewRouter(),
+		ErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			handled = err
+			w.WriteHeader(http.StatusForbidden)
+		},
+	})
+
+	code, _ := do(t, handler, "/things/1", func(r *http.Request) {
+		r.Header.Set("Authorization", "Bearer bad")
+	})
+	assert.Equal(t, http.StatusForbidden, code)
+
+	var securityErr *SecurityError
+	require.ErrorAs(t, handled, &securityErr)
+	require.Len(t, securityErr.Errs, 2)
+	assert.EqualError(t, securityErr.Errs[0], "bad token")
+
+	var missing *MissingCredentialsError
+	require.ErrorAs(t, securityErr.Errs[1], &missing)
+	assert.Equal(t, "ApiKeyAuth", missing.Scheme)
+}
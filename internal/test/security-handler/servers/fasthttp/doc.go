@@ -0,0 +1,3 @@
+package fasthttp
+
+//go:generate go run github.com/oapi-codegen/oapi-codegen/v2/cmd/oapi-codegen --config=config.yaml ../../spec.yaml
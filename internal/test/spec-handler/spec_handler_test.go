@@ -0,0 +1,87 @@
+package spechandler
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
+)
+
+func newTestServer(t *testing.T) *httptest.Server {
+	r := chi.NewRouter()
+	RegisterSpecHandlersWithOptions(r, SpecHandlerOptions{BaseURL: "/v1"})
+	ts := httptest.NewServer(r)
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func get(t *testing.T, url string) (*http.Response, []byte) {
+	client := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}}
+	res, err := client.Get(url)
+	require.NoError(t, err)
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	require.NoError(t, err)
+	return res, body
+}
+
+func TestSpecHandlerJSON(t *testing.T) {
+	ts := newTestServer(t)
+
+	res, body := get(t, ts.URL+"/v1/openapi.json")
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, "application/json", res.Header.Get("Content-Type"))
+
+	var spec struct {
+		Info struct {
+			Title string `json:"title"`
+		} `json:"info"`
+	}
+	require.NoError(t, json.Unmarshal(body, &spec))
+	assert.Equal(t, "Spec handler", spec.Info.Title)
+}
+
+func TestSpecHandlerYAML(t *testing.T) {
+	ts := newTestServer(t)
+
+	res, body := get(t, ts.URL+"/v1/openapi.yaml")
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, "application/yaml", res.Header.Get("Content-Type"))
+
+	var spec yaml.MapSlice
+	require.NoError(t, yaml.Unmarshal(body, &spec))
+	require.NotEmpty(t, spec)
+	// The order of the keys of the JSON spec is kept
+	assert.Equal(t, "components", spec[0].Key)
+}
+
+func TestSpecHandlerDocs(t *testing.T) {
+	ts := newTestServer(t)
+
+	res, _ := get(t, ts.URL+"/v1/docs")
+	assert.Equal(t, http.StatusMovedPermanently, res.StatusCode)
+	assert.Equal(t, "/v1/docs/", res.Header.Get("Location"))
+
+	res, body := get(t, ts.URL+"/v1/docs/")
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Contains(t, string(body), `<script src="swagger-ui-bundle.js"></script>`)
+
+	res, body = get(t, ts.URL+"/v1/docs/swagger-initializer.js")
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Contains(t, string(body), `url: "../openapi.json",`)
+}
+
+func TestSpecHandlerMethodNotAllowed(t *testing.T) {
+	rec := httptest.NewRecorder()
+	SpecHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/openapi.json", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+	assert.Equal(t, "GET, HEAD", rec.Header().Get("Allow"))
+}
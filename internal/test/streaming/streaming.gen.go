@@ -0,0 +1,1260 @@
+// Package streaming provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.0.0-00010101000000-000000000000 DO NOT EDIT.
+package streaming
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/oapi-codegen/runtime"
+	strictnethttp "github.com/oapi-codegen/runtime/strictmiddleware/nethttp"
+)
+
+// Error defines model for Error.
+type Error struct {
+	Message string `json:"message"`
+}
+
+// Event defines model for Event.
+type Event struct {
+	Id      int    `json:"id"`
+	Message string `json:"message"`
+}
+
+// Tick defines model for Tick.
+type Tick struct {
+	Count int `json:"count"`
+}
+
+// CompleteJSONBody defines parameters for Complete.
+type CompleteJSONBody struct {
+	Prompt string `json:"prompt"`
+}
+
+// StreamEventsParams defines parameters for StreamEvents.
+type StreamEventsParams struct {
+	Topic string `form:"topic" json:"topic"`
+}
+
+// CompleteJSONRequestBody defines body for Complete for application/json ContentType.
+type CompleteJSONRequestBody CompleteJSONBody
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// CompleteWithBody request with any body
+	CompleteWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	Complete(ctx context.Context, body CompleteJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// StreamEvents request
+	StreamEvents(ctx context.Context, params *StreamEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// StreamTicks request
+	StreamTicks(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) CompleteWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCompleteRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Complete(ctx context.Context, body CompleteJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCompleteRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) StreamEvents(ctx context.Context, params *StreamEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStreamEventsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) StreamTicks(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStreamTicksRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewCompleteRequest calls the generic Complete builder with application/json body
+func NewCompleteRequest(server string, body CompleteJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCompleteRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCompleteRequestWithBody generates requests for Complete with any type of body
+func NewCompleteRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/completions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewStreamEventsRequest generates requests for StreamEvents
+func NewStreamEventsRequest(server string, params *StreamEventsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/events")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "topic", runtime.ParamLocationQuery, params.Topic); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewStreamTicksRequest generates requests for StreamTicks
+func NewStreamTicksRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/ticks")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// CompleteWithBodyWithResponse request with any body
+	CompleteWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CompleteResponse, error)
+
+	CompleteWithResponse(ctx context.Context, body CompleteJSONRequestBody, reqEditors ...RequestEditorFn) (*CompleteResponse, error)
+
+	// StreamEventsWithResponse request
+	StreamEventsWithResponse(ctx context.Context, params *StreamEventsParams, reqEditors ...RequestEditorFn) (*StreamEventsResponse, error)
+
+	// StreamTicksWithResponse request
+	StreamTicksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*StreamTicksResponse, error)
+}
+
+type CompleteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r CompleteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CompleteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StreamEventsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r StreamEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StreamEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StreamTicksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r StreamTicksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StreamTicksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// CompleteWithBodyWithResponse request with arbitrary body returning *CompleteResponse
+func (c *ClientWithResponses) CompleteWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CompleteResponse, error) {
+	rsp, err := c.CompleteWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCompleteResponse(rsp)
+}
+
+func (c *ClientWithResponses) CompleteWithResponse(ctx context.Context, body CompleteJSONRequestBody, reqEditors ...RequestEditorFn) (*CompleteResponse, error) {
+	rsp, err := c.Complete(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCompleteResponse(rsp)
+}
+
+// StreamEventsWithResponse request returning *StreamEventsResponse
+func (c *ClientWithResponses) StreamEventsWithResponse(ctx context.Context, params *StreamEventsParams, reqEditors ...RequestEditorFn) (*StreamEventsResponse, error) {
+	rsp, err := c.StreamEvents(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStreamEventsResponse(rsp)
+}
+
+// StreamTicksWithResponse request returning *StreamTicksResponse
+func (c *ClientWithResponses) StreamTicksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*StreamTicksResponse, error) {
+	rsp, err := c.StreamTicks(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStreamTicksResponse(rsp)
+}
+
+// ParseCompleteResponse parses an HTTP response from a CompleteWithResponse call
+func ParseCompleteResponse(rsp *http.Response) (*CompleteResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CompleteResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseStreamEventsResponse parses an HTTP response from a StreamEventsWithResponse call
+func ParseStreamEventsResponse(rsp *http.Response) (*StreamEventsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &StreamEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseStreamTicksResponse parses an HTTP response from a StreamTicksWithResponse call
+func ParseStreamTicksResponse(rsp *http.Response) (*StreamTicksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &StreamTicksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// CompleteStream decodes the items of the 200 response to Complete
+// as they're received.
+type CompleteStream struct {
+	HTTPResponse *http.Response
+	decoder      *streamDecoder
+}
+
+// Next returns the next item of the stream, or io.EOF once the stream has
+// ended.
+func (s *CompleteStream) Next() (struct {
+	Done  *bool  `json:"done,omitempty"`
+	Token string `json:"token"`
+}, error) {
+	var item struct {
+		Done  *bool  `json:"done,omitempty"`
+		Token string `json:"token"`
+	}
+	data, err := s.decoder.next()
+	if err != nil {
+		return item, err
+	}
+	if err := json.Unmarshal(data, &item); err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
+// Close closes the body of the response, ending the stream.
+func (s *CompleteStream) Close() error {
+	return s.HTTPResponse.Body.Close()
+}
+
+// CompleteWithBodyWithStream request with arbitrary body returning a *CompleteStream,
+// which must be closed once it's no longer needed
+func (c *ClientWithResponses) CompleteWithBodyWithStream(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CompleteStream, error) {
+	rsp, err := c.CompleteWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCompleteStream(rsp)
+}
+
+func (c *ClientWithResponses) CompleteWithStream(ctx context.Context, body CompleteJSONRequestBody, reqEditors ...RequestEditorFn) (*CompleteStream, error) {
+	rsp, err := c.Complete(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCompleteStream(rsp)
+}
+
+// ParseCompleteStream parses an HTTP response from a CompleteWithStream call. Any
+// response other than the streamed one is returned as a *StreamResponseError.
+func ParseCompleteStream(rsp *http.Response) (*CompleteStream, error) {
+	if rsp.StatusCode != 200 {
+		return nil, newStreamResponseError(rsp)
+	}
+	return &CompleteStream{
+		HTTPResponse: rsp,
+		decoder:      newStreamDecoder(rsp, false),
+	}, nil
+}
+
+// StreamEventsStream decodes the items of the 200 response to StreamEvents
+// as they're received.
+type StreamEventsStream struct {
+	HTTPResponse *http.Response
+	decoder      *streamDecoder
+}
+
+// Next returns the next item of the stream, or io.EOF once the stream has
+// ended.
+func (s *StreamEventsStream) Next() (Event, error) {
+	var item Event
+	data, err := s.decoder.next()
+	if err != nil {
+		return item, err
+	}
+	if err := json.Unmarshal(data, &item); err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
+// Close closes the body of the response, ending the stream.
+func (s *StreamEventsStream) Close() error {
+	return s.HTTPResponse.Body.Close()
+}
+
+// StreamEventsWithStream request returning a *StreamEventsStream,
+// which must be closed once it's no longer needed
+func (c *ClientWithResponses) StreamEventsWithStream(ctx context.Context, params *StreamEventsParams, reqEditors ...RequestEditorFn) (*StreamEventsStream, error) {
+	rsp, err := c.StreamEvents(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStreamEventsStream(rsp)
+}
+
+// ParseStreamEventsStream parses an HTTP response from a StreamEventsWithStream call. Any
+// response other than the streamed one is returned as a *StreamResponseError.
+func ParseStreamEventsStream(rsp *http.Response) (*StreamEventsStream, error) {
+	if rsp.StatusCode != 200 {
+		return nil, newStreamResponseError(rsp)
+	}
+	return &StreamEventsStream{
+		HTTPResponse: rsp,
+		decoder:      newStreamDecoder(rsp, true),
+	}, nil
+}
+
+// StreamTicksStream decodes the items of the 200 response to StreamTicks
+// as they're received.
+type StreamTicksStream struct {
+	HTTPResponse *http.Response
+	decoder      *streamDecoder
+}
+
+// Next returns the next item of the stream, or io.EOF once the stream has
+// ended.
+func (s *StreamTicksStream) Next() (Tick, error) {
+	var item Tick
+	data, err := s.decoder.next()
+	if err != nil {
+		return item, err
+	}
+	if err := json.Unmarshal(data, &item); err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
+// Close closes the body of the response, ending the stream.
+func (s *StreamTicksStream) Close() error {
+	return s.HTTPResponse.Body.Close()
+}
+
+// StreamTicksWithStream request returning a *StreamTicksStream,
+// which must be closed once it's no longer needed
+func (c *ClientWithResponses) StreamTicksWithStream(ctx context.Context, reqEditors ...RequestEditorFn) (*StreamTicksStream, error) {
+	rsp, err := c.StreamTicks(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStreamTicksStream(rsp)
+}
+
+// ParseStreamTicksStream parses an HTTP response from a StreamTicksWithStream call. Any
+// response other than the streamed one is returned as a *StreamResponseError.
+func ParseStreamTicksStream(rsp *http.Response) (*StreamTicksStream, error) {
+	if rsp.StatusCode != 200 {
+		return nil, newStreamResponseError(rsp)
+	}
+	return &StreamTicksStream{
+		HTTPResponse: rsp,
+		decoder:      newStreamDecoder(rsp, false),
+	}, nil
+}
+
+// StreamResponseError is returned when a streamed operation responds with
+// anything other than its stream.
+type StreamResponseError struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+func newStreamResponseError(rsp *http.Response) *StreamResponseError {
+	defer func() { _ = rsp.Body.Close() }()
+	body, _ := io.ReadAll(rsp.Body)
+	return &StreamResponseError{Body: body, HTTPResponse: rsp}
+}
+
+func (e *StreamResponseError) Error() string {
+	return fmt.Sprintf("unexpected response to stream: %s", e.HTTPResponse.Status)
+}
+
+// streamDecoder reads the data of each item of a stream of Server-Sent Events,
+// or of newline-delimited JSON.
+type streamDecoder struct {
+	reader      *bufio.Reader
+	eventStream bool
+}
+
+// newStreamDecoder returns a decoder of the body of rsp, which is read as
+// Server-Sent Events if that's its content type, or if it has none and
+// eventStream is set.
+func newStreamDecoder(rsp *http.Response, eventStream bool) *streamDecoder {
+	if mediaType, _, err := mime.ParseMediaType(rsp.Header.Get("Content-Type")); err == nil {
+		eventStream = mediaType == "text/event-stream"
+	}
+	return &streamDecoder{reader: bufio.NewReader(rsp.Body), eventStream: eventStream}
+}
+
+// next returns the data of the next item, or io.EOF once the stream has ended.
+// The data of an event is joined from all of its data fields, while its other
+// fields, and comments, are ignored.
+func (d *streamDecoder) next() ([]byte, error) {
+	var data [][]byte
+	for {
+		line, err := d.reader.ReadBytes('\n')
+		if err != nil && (err != io.EOF || len(line) == 0) {
+			if err == io.EOF && len(data) > 0 {
+				return bytes.Join(data, []byte("\n")), nil
+			}
+			return nil, err
+		}
+		line = bytes.TrimRight(line, "\r\n")
+
+		if !d.eventStream {
+			if len(bytes.TrimSpace(line)) > 0 {
+				return line, nil
+			}
+			continue
+		}
+
+		if len(line) == 0 {
+			// A blank line ends the event
+			if len(data) > 0 {
+				return bytes.Join(data, []byte("\n")), nil
+			}
+			continue
+		}
+		if field, value, _ := bytes.Cut(line, []byte(":")); string(field) == "data" {
+			data = append(data, bytes.TrimPrefix(value, []byte(" ")))
+		}
+	}
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+
+	// (POST /completions)
+	Complete(w http.ResponseWriter, r *http.Request)
+
+	// (GET /events)
+	StreamEvents(w http.ResponseWriter, r *http.Request, params StreamEventsParams)
+
+	// (GET /ticks)
+	StreamTicks(w http.ResponseWriter, r *http.Request)
+}
+
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+
+type Unimplemented struct{}
+
+// (POST /completions)
+func (_ Unimplemented) Complete(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /events)
+func (_ Unimplemented) StreamEvents(w http.ResponseWriter, r *http.Request, params StreamEventsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /ticks)
+func (_ Unimplemented) StreamTicks(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// Complete operation middleware
+func (siw *ServerInterfaceWrapper) Complete(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Complete(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// StreamEvents operation middleware
+func (siw *ServerInterfaceWrapper) StreamEvents(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StreamEventsParams
+
+	// ------------- Required query parameter "topic" -------------
+
+	if paramValue := r.URL.Query().Get("topic"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "topic"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "topic", r.URL.Query(), &params.Topic)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "topic", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StreamEvents(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// StreamTicks operation middleware
+func (siw *ServerInterfaceWrapper) StreamTicks(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StreamTicks(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/completions", wrapper.Complete)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/events", wrapper.StreamEvents)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/ticks", wrapper.StreamTicks)
+	})
+
+	return r
+}
+
+type TicksResponseHeaders struct {
+	XInterval string
+}
+type TicksApplicationxNdjsonResponse struct {
+	Events   <-chan Tick
+	EventSeq func(yield func(Tick) bool)
+
+	Headers TicksResponseHeaders
+}
+
+type CompleteRequestObject struct {
+	Body *CompleteJSONRequestBody
+}
+
+type CompleteResponseObject interface {
+	VisitCompleteResponse(w http.ResponseWriter) error
+}
+
+type Complete200ApplicationxNdjsonResponse struct {
+	Events <-chan struct {
+		Done  *bool  `json:"done,omitempty"`
+		Token string `json:"token"`
+	}
+	EventSeq func(yield func(struct {
+		Done  *bool  `json:"done,omitempty"`
+		Token string `json:"token"`
+	}) bool)
+}
+
+func (response Complete200ApplicationxNdjsonResponse) VisitCompleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.WriteHeader(200)
+
+	flusher, _ := w.(http.Flusher)
+	if flusher != nil {
+		flusher.Flush()
+	}
+	write := func(event struct {
+		Done  *bool  `json:"done,omitempty"`
+		Token string `json:"token"`
+	}) error {
+		data, err := json.Marshal(event)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(append(data, '\n'))
+		if err == nil && flusher != nil {
+			flusher.Flush()
+		}
+		return err
+	}
+	if response.EventSeq != nil {
+		var err error
+		response.EventSeq(func(event struct {
+			Done  *bool  `json:"done,omitempty"`
+			Token string `json:"token"`
+		}) bool {
+			err = write(event)
+			return err == nil
+		})
+		return err
+	}
+	for event := range response.Events {
+		if err := write(event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type StreamEventsRequestObject struct {
+	Params StreamEventsParams
+}
+
+type StreamEventsResponseObject interface {
+	VisitStreamEventsResponse(w http.ResponseWriter) error
+}
+
+type StreamEvents200TexteventStreamResponse struct {
+	Events   <-chan Event
+	EventSeq func(yield func(Event) bool)
+}
+
+func (response StreamEvents200TexteventStreamResponse) VisitStreamEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.WriteHeader(200)
+
+	flusher, _ := w.(http.Flusher)
+	if flusher != nil {
+		flusher.Flush()
+	}
+	write := func(event Event) error {
+		data, err := json.Marshal(event)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(w, "data: %s\n\n", data)
+		if err == nil && flusher != nil {
+			flusher.Flush()
+		}
+		return err
+	}
+	if response.EventSeq != nil {
+		var err error
+		response.EventSeq(func(event Event) bool {
+			err = write(event)
+			return err == nil
+		})
+		return err
+	}
+	for event := range response.Events {
+		if err := write(event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type StreamEvents404JSONResponse Error
+
+func (response StreamEvents404JSONResponse) VisitStreamEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StreamTicksRequestObject struct {
+}
+
+type StreamTicksResponseObject interface {
+	VisitStreamTicksResponse(w http.ResponseWriter) error
+}
+
+type StreamTicks200ApplicationxNdjsonResponse struct {
+	TicksApplicationxNdjsonResponse
+}
+
+func (response StreamTicks200ApplicationxNdjsonResponse) VisitStreamTicksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.Header().Set("X-Interval", fmt.Sprint(response.Headers.XInterval))
+	w.WriteHeader(200)
+
+	flusher, _ := w.(http.Flusher)
+	if flusher != nil {
+		flusher.Flush()
+	}
+	write := func(event Tick) error {
+		data, err := json.Marshal(event)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(append(data, '\n'))
+		if err == nil && flusher != nil {
+			flusher.Flush()
+		}
+		return err
+	}
+	if response.EventSeq != nil {
+		var err error
+		response.EventSeq(func(event Tick) bool {
+			err = write(event)
+			return err == nil
+		})
+		return err
+	}
+	for event := range response.Events {
+		if err := write(event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+
+	// (POST /completions)
+	Complete(ctx context.Context, request CompleteRequestObject) (CompleteResponseObject, error)
+
+	// (GET /events)
+	StreamEvents(ctx context.Context, request StreamEventsRequestObject) (StreamEventsResponseObject, error)
+
+	// (GET /ticks)
+	StreamTicks(ctx context.Context, request StreamTicksRequestObject) (StreamTicksResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// Complete operation middleware
+func (sh *strictHandler) Complete(w http.ResponseWriter, r *http.Request) {
+	var request CompleteRequestObject
+
+	var body CompleteJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.Complete(ctx, request.(CompleteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "Complete")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CompleteResponseObject); ok {
+		if err := validResponse.VisitCompleteResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// StreamEvents operation middleware
+func (sh *strictHandler) StreamEvents(w http.ResponseWriter, r *http.Request, params StreamEventsParams) {
+	var request StreamEventsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.StreamEvents(ctx, request.(StreamEventsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StreamEvents")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(StreamEventsResponseObject); ok {
+		if err := validResponse.VisitStreamEventsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// StreamTicks operation middleware
+func (sh *strictHandler) StreamTicks(w http.ResponseWriter, r *http.Request) {
+	var request StreamTicksRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.StreamTicks(ctx, request.(StreamTicksRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StreamTicks")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(StreamTicksResponseObject); ok {
+		if err := validResponse.VisitStreamTicksResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
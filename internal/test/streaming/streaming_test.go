@@ -0,0 +1,197 @@
+package streaming
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// token is the item of the Complete stream
+type token = struct {
+	Done  *bool  `json:"done,omitempty"`
+	Token string `json:"token"`
+}
+
+type streamingServer struct {
+	// received is signalled by the client for each event it receives, so
+	// that the next event is only sent once the previous one was flushed
+	received chan struct{}
+}
+
+func (s *streamingServer) StreamEvents(ctx context.Context, request StreamEventsRequestObject) (StreamEventsResponseObject, error) {
+	if request.Params.Topic != "pets" {
+		return StreamEvents404JSONResponse{Message: "no such topic"}, nil
+	}
+	events := make(chan Event)
+	go func() {
+		defer close(events)
+		for i, message := range []string{"adopted", "fed", "walked"} {
+			select {
+			case events <- Event{Id: i + 1, Message: message}:
+			case <-ctx.Done():
+				return
+			}
+			select {
+			case <-s.received:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return StreamEvents200TexteventStreamResponse{Events: events}, nil
+}
+
+func (s *streamingServer) Complete(ctx context.Context, request CompleteRequestObject) (CompleteResponseObject, error) {
+	words := strings.Fields(request.Body.Prompt)
+	return Complete200ApplicationxNdjsonResponse{
+		EventSeq: func(yield func(token) bool) {
+			done := true
+			for i, word := range words {
+				item := token{Token: word}
+				if i == len(words)-1 {
+					item.Done = &done
+				}
+				if !yield(item) {
+					return
+				}
+			}
+		},
+	}, nil
+}
+
+func (s *streamingServer) StreamTicks(ctx context.Context, request StreamTicksRequestObject) (StreamTicksResponseObject, error) {
+	ticks := make(chan Tick, 2)
+	ticks <- Tick{Count: 1}
+	ticks <- Tick{Count: 2}
+	close(ticks)
+	return StreamTicks200ApplicationxNdjsonResponse{TicksApplicationxNdjsonResponse{
+		Events:  ticks,
+		Headers: TicksResponseHeaders{XInterval: "1s"},
+	}}, nil
+}
+
+func newTestClient(t *testing.T, server *streamingServer) *ClientWithResponses {
+	ts := httptest.NewServer(Handler(NewStrictHandler(server, nil)))
+	t.Cleanup(ts.Close)
+
+	client, err := NewClientWithResponses(ts.URL)
+	require.NoError(t, err)
+	return client
+}
+
+func TestEventStream(t *testing.T) {
+	server := &streamingServer{received: make(chan struct{})}
+	client := newTestClient(t, server)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	stream, err := client.StreamEventsWithStream(ctx, &StreamEventsParams{Topic: "pets"})
+	require.NoError(t, err)
+	defer stream.Close()
+	assert.Equal(t, "text/event-stream", stream.HTTPResponse.Header.Get("Content-Type"))
+
+	// Each event is only sent once the previous one has been received, so
+	// this would time out if the events weren't flushed as they're written.
+	var events []Event
+	for {
+		event, err := stream.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+		events = append(events, event)
+		server.received <- struct{}{}
+	}
+	assert.Equal(t, []Event{{Id: 1, Message: "adopted"}, {Id: 2, Message: "fed"}, {Id: 3, Message: "walked"}}, events)
+}
+
+func TestEventStreamError(t *testing.T) {
+	client := newTestClient(t, &streamingServer{})
+
+	_, err := client.StreamEventsWithStream(context.Background(), &StreamEventsParams{Topic: "cats"})
+	var streamErr *StreamResponseError
+	require.ErrorAs(t, err, &streamErr)
+	assert.Equal(t, http.StatusNotFound, streamErr.HTTPResponse.StatusCode)
+	assert.JSONEq(t, `{"message": "no such topic"}`, string(streamErr.Body))
+}
+
+func TestNDJSONStream(t *testing.T) {
+	client := newTestClient(t, &streamingServer{})
+
+	stream, err := client.CompleteWithStream(context.Background(), CompleteJSONRequestBody{Prompt: "good dog"})
+	require.NoError(t, err)
+	defer stream.Close()
+	assert.Equal(t, "application/x-ndjson", stream.HTTPResponse.Header.Get("Content-Type"))
+
+	first, err := stream.Next()
+	require.NoError(t, err)
+	assert.Equal(t, token{Token: "good"}, first)
+
+	last, err := stream.Next()
+	require.NoError(t, err)
+	assert.Equal(t, "dog", last.Token)
+	require.NotNil(t, last.Done)
+	assert.True(t, *last.Done)
+
+	_, err = stream.Next()
+	assert.ErrorIs(t, err, io.EOF)
+}
+
+func TestReferencedResponseStream(t *testing.T) {
+	client := newTestClient(t, &streamingServer{})
+
+	stream, err := client.StreamTicksWithStream(context.Background())
+	require.NoError(t, err)
+	defer stream.Close()
+	assert.Equal(t, "1s", stream.HTTPResponse.Header.Get("X-Interval"))
+
+	var ticks []Tick
+	for {
+		tick, err := stream.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+		ticks = append(ticks, tick)
+	}
+	assert.Equal(t, []Tick{{Count: 1}, {Count: 2}}, ticks)
+}
+
+func TestEventStreamDecoding(t *testing.T) {
+	body := ": a comment\n" +
+		"id: 1\n" +
+		"event: update\n" +
+		"data: {\"id\": 1,\n" +
+		"data: \"message\": \"adopted\"}\n" +
+		"\r\n" +
+		"\n" +
+		"data:{\"id\": 2, \"message\": \"fed\"}"
+	rsp := &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"text/event-stream; charset=utf-8"}},
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}
+
+	stream, err := ParseStreamEventsStream(rsp)
+	require.NoError(t, err)
+
+	event, err := stream.Next()
+	require.NoError(t, err)
+	assert.Equal(t, Event{Id: 1, Message: "adopted"}, event)
+
+	event, err = stream.Next()
+	require.NoError(t, err)
+	assert.Equal(t, Event{Id: 2, Message: "fed"}, event)
+
+	_, err = stream.Next()
+	assert.ErrorIs(t, err, io.EOF)
+}
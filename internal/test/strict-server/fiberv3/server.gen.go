@@ -0,0 +1,1304 @@
+// Package api provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.0.0-00010101000000-000000000000 DO NOT EDIT.
+package api
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gofiber/fiber/v3"
+	"github.com/oapi-codegen/runtime"
+)
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+
+	// (POST /json)
+	JSONExample(c fiber.Ctx) error
+
+	// (POST /multipart)
+	MultipartExample(c fiber.Ctx) error
+
+	// (POST /multipart-related)
+	MultipartRelatedExample(c fiber.Ctx) error
+
+	// (POST /multiple)
+	MultipleRequestAndResponseTypes(c fiber.Ctx) error
+
+	// (GET /reserved-go-keyword-parameters/{type})
+	ReservedGoKeywordParameters(c fiber.Ctx, pType string) error
+
+	// (POST /reusable-responses)
+	ReusableResponses(c fiber.Ctx) error
+
+	// (POST /text)
+	TextExample(c fiber.Ctx) error
+
+	// (POST /unknown)
+	UnknownExample(c fiber.Ctx) error
+
+	// (POST /unspecified-content-type)
+	UnspecifiedContentType(c fiber.Ctx) error
+
+	// (POST /urlencoded)
+	URLEncodedExample(c fiber.Ctx) error
+
+	// (POST /with-headers)
+	HeadersExample(c fiber.Ctx, params HeadersExampleParams) error
+
+	// (POST /with-union)
+	UnionExample(c fiber.Ctx) error
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+type MiddlewareFunc fiber.Handler
+
+// JSONExample operation middleware
+func (siw *ServerInterfaceWrapper) JSONExample(c fiber.Ctx) error {
+
+	return siw.Handler.JSONExample(c)
+}
+
+// MultipartExample operation middleware
+func (siw *ServerInterfaceWrapper) MultipartExample(c fiber.Ctx) error {
+
+	return siw.Handler.MultipartExample(c)
+}
+
+// MultipartRelatedExample operation middleware
+func (siw *ServerInterfaceWrapper) MultipartRelatedExample(c fiber.Ctx) error {
+
+	return siw.Handler.MultipartRelatedExample(c)
+}
+
+// MultipleRequestAndResponseTypes operation middleware
+func (siw *ServerInterfaceWrapper) MultipleRequestAndResponseTypes(c fiber.Ctx) error {
+
+	return siw.Handler.MultipleRequestAndResponseTypes(c)
+}
+
+// ReservedGoKeywordParameters operation middleware
+func (siw *ServerInterfaceWrapper) ReservedGoKeywordParameters(c fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "type" -------------
+	var pType string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "type", c.Params("type"), &pType, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter type: %w", err).Error())
+	}
+
+	return siw.Handler.ReservedGoKeywordParameters(c, pType)
+}
+
+// ReusableResponses operation middleware
+func (siw *ServerInterfaceWrapper) ReusableResponses(c fiber.Ctx) error {
+
+	return siw.Handler.ReusableResponses(c)
+}
+
+// TextExample operation middleware
+func (siw *ServerInterfaceWrapper) TextExample(c fiber.Ctx) error {
+
+	return siw.Handler.TextExample(c)
+}
+
+// UnknownExample operation middleware
+func (siw *ServerInterfaceWrapper) UnknownExample(c fiber.Ctx) error {
+
+	return siw.Handler.UnknownExample(c)
+}
+
+// UnspecifiedContentType operation middleware
+func (siw *ServerInterfaceWrapper) UnspecifiedContentType(c fiber.Ctx) error {
+
+	return siw.Handler.UnspecifiedContentType(c)
+}
+
+// URLEncodedExample operation middleware
+func (siw *ServerInterfaceWrapper) URLEncodedExample(c fiber.Ctx) error {
+
+	return siw.Handler.URLEncodedExample(c)
+}
+
+// HeadersExample operation middleware
+func (siw *ServerInterfaceWrapper) HeadersExample(c fiber.Ctx) error {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params HeadersExampleParams
+
+	headers := c.GetReqHeaders()
+
+	// ------------- Required header parameter "header1" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("header1")]; found {
+		var Header1 string
+		n := len(valueList)
+		if n != 1 {
+			return fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("Expected one value for header1, got %d", n))
+		}
+		value := valueList[0]
+
+		err = runtime.BindStyledParameterWithOptions("simple", "header1", value, &Header1, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter header1: %w", err).Error())
+		}
+
+		params.Header1 = Header1
+
+	} else {
+		return fiber.NewError(fiber.StatusBadRequest, "Header parameter header1 is required, but not found")
+	}
+
+	// ------------- Optional header parameter "header2" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("header2")]; found {
+		var Header2 int
+		n := len(valueList)
+		if n != 1 {
+			return fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("Expected one value for header2, got %d", n))
+		}
+		value := valueList[0]
+
+		err = runtime.BindStyledParameterWithOptions("simple", "header2", value, &Header2, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter header2: %w", err).Error())
+		}
+
+		params.Header2 = &Header2
+
+	}
+
+	return siw.Handler.HeadersExample(c, params)
+}
+
+// UnionExample operation middleware
+func (siw *ServerInterfaceWrapper) UnionExample(c fiber.Ctx) error {
+
+	return siw.Handler.UnionExample(c)
+}
+
+// FiberServerOptions provides options for the Fiber v3 server.
+type FiberServerOptions struct {
+	BaseURL     string
+	Middlewares []MiddlewareFunc
+}
+
+// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
+func RegisterHandlers(router fiber.Router, si ServerInterface) {
+	RegisterHandlersWithOptions(router, si, FiberServerOptions{})
+}
+
+// RegisterHandlersWithOptions creates http.Handler with additional options
+func RegisterHandlersWithOptions(router fiber.Router, si ServerInterface, options FiberServerOptions) {
+	wrapper := ServerInterfaceWrapper{
+		Handler: si,
+	}
+
+	for _, m := range options.Middlewares {
+		router.Use(fiber.Handler(m))
+	}
+
+	router.Post(options.BaseURL+"/json", wrapper.JSONExample)
+
+	router.Post(options.BaseURL+"/multipart", wrapper.MultipartExample)
+
+	router.Post(options.BaseURL+"/multipart-related", wrapper.MultipartRelatedExample)
+
+	router.Post(options.BaseURL+"/multiple", wrapper.MultipleRequestAndResponseTypes)
+
+	router.Get(options.BaseURL+"/reserved-go-keyword-parameters/:type", wrapper.ReservedGoKeywordParameters)
+
+	router.Post(options.BaseURL+"/reusable-responses", wrapper.ReusableResponses)
+
+	router.Post(options.BaseURL+"/text", wrapper.TextExample)
+
+	router.Post(options.BaseURL+"/unknown", wrapper.UnknownExample)
+
+	router.Post(options.BaseURL+"/unspecified-content-type", wrapper.UnspecifiedContentType)
+
+	router.Post(options.BaseURL+"/urlencoded", wrapper.URLEncodedExample)
+
+	router.Post(options.BaseURL+"/with-headers", wrapper.HeadersExample)
+
+	router.Post(options.BaseURL+"/with-union", wrapper.UnionExample)
+
+}
+
+type BadrequestResponse struct {
+}
+
+type ReusableresponseResponseHeaders struct {
+	Header1 string
+	Header2 int
+}
+type ReusableresponseJSONResponse struct {
+	Body Example
+
+	Headers ReusableresponseResponseHeaders
+}
+
+type JSONExampleRequestObject struct {
+	Body *JSONExampleJSONRequestBody
+}
+
+type JSONExampleResponseObject interface {
+	VisitJSONExampleResponse(ctx fiber.Ctx) error
+}
+
+type JSONExample200JSONResponse Example
+
+func (response JSONExample200JSONResponse) VisitJSONExampleResponse(ctx fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type JSONExample400Response = BadrequestResponse
+
+func (response JSONExample400Response) VisitJSONExampleResponse(ctx fiber.Ctx) error {
+	ctx.Status(400)
+	return nil
+}
+
+type JSONExampledefaultResponse struct {
+	StatusCode int
+}
+
+func (response JSONExampledefaultResponse) VisitJSONExampleResponse(ctx fiber.Ctx) error {
+	ctx.Status(response.StatusCode)
+	return nil
+}
+
+type MultipartExampleRequestObject struct {
+	Body *multipart.Reader
+}
+
+type MultipartExampleResponseObject interface {
+	VisitMultipartExampleResponse(ctx fiber.Ctx) error
+}
+
+type MultipartExample200MultipartResponse func(writer *multipart.Writer) error
+
+func (response MultipartExample200MultipartResponse) VisitMultipartExampleResponse(ctx fiber.Ctx) error {
+	writer := multipart.NewWriter(ctx.Response().BodyWriter())
+	ctx.Response().Header.Set("Content-Type", writer.FormDataContentType())
+	ctx.Status(200)
+
+	defer writer.Close()
+	return response(writer)
+}
+
+type MultipartExample400Response = BadrequestResponse
+
+func (response MultipartExample400Response) VisitMultipartExampleResponse(ctx fiber.Ctx) error {
+	ctx.Status(400)
+	return nil
+}
+
+type MultipartExampledefaultResponse struct {
+	StatusCode int
+}
+
+func (response MultipartExampledefaultResponse) VisitMultipartExampleResponse(ctx fiber.Ctx) error {
+	ctx.Status(response.StatusCode)
+	return nil
+}
+
+type MultipartRelatedExampleRequestObject struct {
+	Body *multipart.Reader
+}
+
+type MultipartRelatedExampleResponseObject interface {
+	VisitMultipartRelatedExampleResponse(ctx fiber.Ctx) error
+}
+
+type MultipartRelatedExample200MultipartResponse func(writer *multipart.Writer) error
+
+func (response MultipartRelatedExample200MultipartResponse) VisitMultipartRelatedExampleResponse(ctx fiber.Ctx) error {
+	writer := multipart.NewWriter(ctx.Response().BodyWriter())
+	ctx.Response().Header.Set("Content-Type", mime.FormatMediaType("multipart/related", map[string]string{"boundary": writer.Boundary()}))
+	ctx.Status(200)
+
+	defer writer.Close()
+	return response(writer)
+}
+
+type MultipartRelatedExample400Response = BadrequestResponse
+
+func (response MultipartRelatedExample400Response) VisitMultipartRelatedExampleResponse(ctx fiber.Ctx) error {
+	ctx.Status(400)
+	return nil
+}
+
+type MultipartRelatedExampledefaultResponse struct {
+	StatusCode int
+}
+
+func (response MultipartRelatedExampledefaultResponse) VisitMultipartRelatedExampleResponse(ctx fiber.Ctx) error {
+	ctx.Status(response.StatusCode)
+	return nil
+}
+
+type MultipleRequestAndResponseTypesRequestObject struct {
+	JSONBody      *MultipleRequestAndResponseTypesJSONRequestBody
+	FormdataBody  *MultipleRequestAndResponseTypesFormdataRequestBody
+	Body          io.Reader
+	MultipartBody *multipart.Reader
+	TextBody      *MultipleRequestAndResponseTypesTextRequestBody
+}
+
+type MultipleRequestAndResponseTypesResponseObject interface {
+	VisitMultipleRequestAndResponseTypesResponse(ctx fiber.Ctx) error
+}
+
+type MultipleRequestAndResponseTypes200JSONResponse Example
+
+func (response MultipleRequestAndResponseTypes200JSONResponse) VisitMultipleRequestAndResponseTypesResponse(ctx fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type MultipleRequestAndResponseTypes200FormdataResponse Example
+
+func (response MultipleRequestAndResponseTypes200FormdataResponse) VisitMultipleRequestAndResponseTypesResponse(ctx fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	ctx.Status(200)
+
+	if form, err := runtime.MarshalForm(response, nil); err != nil {
+		return err
+	} else {
+		_, err := ctx.WriteString(form.Encode())
+		return err
+	}
+}
+
+type MultipleRequestAndResponseTypes200ImagepngResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response MultipleRequestAndResponseTypes200ImagepngResponse) VisitMultipleRequestAndResponseTypesResponse(ctx fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "image/png")
+	if response.ContentLength != 0 {
+		ctx.Response().Header.Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	ctx.Status(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(ctx.Response().BodyWriter(), response.Body)
+	return err
+}
+
+type MultipleRequestAndResponseTypes200MultipartResponse func(writer *multipart.Writer) error
+
+func (response MultipleRequestAndResponseTypes200MultipartResponse) VisitMultipleRequestAndResponseTypesResponse(ctx fiber.Ctx) error {
+	writer := multipart.NewWriter(ctx.Response().BodyWriter())
+	ctx.Response().Header.Set("Content-Type", writer.FormDataContentType())
+	ctx.Status(200)
+
+	defer writer.Close()
+	return response(writer)
+}
+
+type MultipleRequestAndResponseTypes200TextResponse string
+
+func (response MultipleRequestAndResponseTypes200TextResponse) VisitMultipleRequestAndResponseTypesResponse(ctx fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "text/plain")
+	ctx.Status(200)
+
+	_, err := ctx.WriteString(string(response))
+	return err
+}
+
+type MultipleRequestAndResponseTypes400Response = BadrequestResponse
+
+func (response MultipleRequestAndResponseTypes400Response) VisitMultipleRequestAndResponseTypesResponse(ctx fiber.Ctx) error {
+	ctx.Status(400)
+	return nil
+}
+
+type ReservedGoKeywordParametersRequestObject struct {
+	Type string `json:"type"`
+}
+
+type ReservedGoKeywordParametersResponseObject interface {
+	VisitReservedGoKeywordParametersResponse(ctx fiber.Ctx) error
+}
+
+type ReservedGoKeywordParameters200TextResponse string
+
+func (response ReservedGoKeywordParameters200TextResponse) VisitReservedGoKeywordParametersResponse(ctx fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "text/plain")
+	ctx.Status(200)
+
+	_, err := ctx.WriteString(string(response))
+	return err
+}
+
+type ReusableResponsesRequestObject struct {
+	Body *ReusableResponsesJSONRequestBody
+}
+
+type ReusableResponsesResponseObject interface {
+	VisitReusableResponsesResponse(ctx fiber.Ctx) error
+}
+
+type ReusableResponses200JSONResponse struct{ ReusableresponseJSONResponse }
+
+func (response ReusableResponses200JSONResponse) VisitReusableResponsesResponse(ctx fiber.Ctx) error {
+	ctx.Response().Header.Set("header1", fmt.Sprint(response.Headers.Header1))
+	ctx.Response().Header.Set("header2", fmt.Sprint(response.Headers.Header2))
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response.Body)
+}
+
+type ReusableResponses400Response = BadrequestResponse
+
+func (response ReusableResponses400Response) VisitReusableResponsesResponse(ctx fiber.Ctx) error {
+	ctx.Status(400)
+	return nil
+}
+
+type ReusableResponsesdefaultResponse struct {
+	StatusCode int
+}
+
+func (response ReusableResponsesdefaultResponse) VisitReusableResponsesResponse(ctx fiber.Ctx) error {
+	ctx.Status(response.StatusCode)
+	return nil
+}
+
+type TextExampleRequestObject struct {
+	Body *TextExampleTextRequestBody
+}
+
+type TextExampleResponseObject interface {
+	VisitTextExampleResponse(ctx fiber.Ctx) error
+}
+
+type TextExample200TextResponse string
+
+func (response TextExample200TextResponse) VisitTextExampleResponse(ctx fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "text/plain")
+	ctx.Status(200)
+
+	_, err := ctx.WriteString(string(response))
+	return err
+}
+
+type TextExample400Response = BadrequestResponse
+
+func (response TextExample400Response) VisitTextExampleResponse(ctx fiber.Ctx) error {
+	ctx.Status(400)
+	return nil
+}
+
+type TextExampledefaultResponse struct {
+	StatusCode int
+}
+
+func (response TextExampledefaultResponse) VisitTextExampleResponse(ctx fiber.Ctx) error {
+	ctx.Status(response.StatusCode)
+	return nil
+}
+
+type UnknownExampleRequestObject struct {
+	Body io.Reader
+}
+
+type UnknownExampleResponseObject interface {
+	VisitUnknownExampleResponse(ctx fiber.Ctx) error
+}
+
+type UnknownExample200Videomp4Response struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response UnknownExample200Videomp4Response) VisitUnknownExampleResponse(ctx fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "video/mp4")
+	if response.ContentLength != 0 {
+		ctx.Response().Header.Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	ctx.Status(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(ctx.Response().BodyWriter(), response.Body)
+	return err
+}
+
+type UnknownExample400Response = BadrequestResponse
+
+func (response UnknownExample400Response) VisitUnknownExampleResponse(ctx fiber.Ctx) error {
+	ctx.Status(400)
+	return nil
+}
+
+type UnknownExampledefaultResponse struct {
+	StatusCode int
+}
+
+func (response UnknownExampledefaultResponse) VisitUnknownExampleResponse(ctx fiber.Ctx) error {
+	ctx.Status(response.StatusCode)
+	return nil
+}
+
+type UnspecifiedContentTypeRequestObject struct {
+	ContentType string
+	Body        io.Reader
+}
+
+type UnspecifiedContentTypeResponseObject interface {
+	VisitUnspecifiedContentTypeResponse(ctx fiber.Ctx) error
+}
+
+type UnspecifiedContentType200VideoResponse struct {
+	Body          io.Reader
+	ContentType   string
+	ContentLength int64
+}
+
+func (response UnspecifiedContentType200VideoResponse) VisitUnspecifiedContentTypeResponse(ctx fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", response.ContentType)
+	if response.ContentLength != 0 {
+		ctx.Response().Header.Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	ctx.Status(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(ctx.Response().BodyWriter(), response.Body)
+	return err
+}
+
+type UnspecifiedContentType400Response = BadrequestResponse
+
+func (response UnspecifiedContentType400Response) VisitUnspecifiedContentTypeResponse(ctx fiber.Ctx) error {
+	ctx.Status(400)
+	return nil
+}
+
+type UnspecifiedContentType401Response struct {
+}
+
+func (response UnspecifiedContentType401Response) VisitUnspecifiedContentTypeResponse(ctx fiber.Ctx) error {
+	ctx.Status(401)
+	return nil
+}
+
+type UnspecifiedContentType403Response struct {
+}
+
+func (response UnspecifiedContentType403Response) VisitUnspecifiedContentTypeResponse(ctx fiber.Ctx) error {
+	ctx.Status(403)
+	return nil
+}
+
+type UnspecifiedContentTypedefaultResponse struct {
+	StatusCode int
+}
+
+func (response UnspecifiedContentTypedefaultResponse) VisitUnspecifiedContentTypeResponse(ctx fiber.Ctx) error {
+	ctx.Status(response.StatusCode)
+	return nil
+}
+
+type URLEncodedExampleRequestObject struct {
+	Body *URLEncodedExampleFormdataRequestBody
+}
+
+type URLEncodedExampleResponseObject interface {
+	VisitURLEncodedExampleResponse(ctx fiber.Ctx) error
+}
+
+type URLEncodedExample200FormdataResponse Example
+
+func (response URLEncodedExample200FormdataResponse) VisitURLEncodedExampleResponse(ctx fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	ctx.Status(200)
+
+	if form, err := runtime.MarshalForm(response, nil); err != nil {
+		return err
+	} else {
+		_, err := ctx.WriteString(form.Encode())
+		return err
+	}
+}
+
+type URLEncodedExample400Response = BadrequestResponse
+
+func (response URLEncodedExample400Response) VisitURLEncodedExampleResponse(ctx fiber.Ctx) error {
+	ctx.Status(400)
+	return nil
+}
+
+type URLEncodedExampledefaultResponse struct {
+	StatusCode int
+}
+
+func (response URLEncodedExampledefaultResponse) VisitURLEncodedExampleResponse(ctx fiber.Ctx) error {
+	ctx.Status(response.StatusCode)
+	return nil
+}
+
+type HeadersExampleRequestObject struct {
+	Params HeadersExampleParams
+	Body   *HeadersExampleJSONRequestBody
+}
+
+type HeadersExampleResponseObject interface {
+	VisitHeadersExampleResponse(ctx fiber.Ctx) error
+}
+
+type HeadersExample200ResponseHeaders struct {
+	Header1 string
+	Header2 int
+}
+
+type HeadersExample200JSONResponse struct {
+	Body    Example
+	Headers HeadersExample200ResponseHeaders
+}
+
+func (response HeadersExample200JSONResponse) VisitHeadersExampleResponse(ctx fiber.Ctx) error {
+	ctx.Response().Header.Set("header1", fmt.Sprint(response.Headers.Header1))
+	ctx.Response().Header.Set("header2", fmt.Sprint(response.Headers.Header2))
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response.Body)
+}
+
+type HeadersExample400Response = BadrequestResponse
+
+func (response HeadersExample400Response) VisitHeadersExampleResponse(ctx fiber.Ctx) error {
+	ctx.Status(400)
+	return nil
+}
+
+type HeadersExampledefaultResponse struct {
+	StatusCode int
+}
+
+func (response HeadersExampledefaultResponse) VisitHeadersExampleResponse(ctx fiber.Ctx) error {
+	ctx.Status(response.StatusCode)
+	return nil
+}
+
+type UnionExampleRequestObject struct {
+	Body *UnionExampleJSONRequestBody
+}
+
+type UnionExampleResponseObject interface {
+	VisitUnionExampleResponse(ctx fiber.Ctx) error
+}
+
+type UnionExample200ResponseHeaders struct {
+	Header1 string
+	Header2 int
+}
+
+type UnionExample200ApplicationAlternativePlusJSONResponse struct {
+	Body    Example
+	Headers UnionExample200ResponseHeaders
+}
+
+func (response UnionExample200ApplicationAlternativePlusJSONResponse) VisitUnionExampleResponse(ctx fiber.Ctx) error {
+	ctx.Response().Header.Set("header1", fmt.Sprint(response.Headers.Header1))
+	ctx.Response().Header.Set("header2", fmt.Sprint(response.Headers.Header2))
+	ctx.Response().Header.Set("Content-Type", "application/alternative+json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response.Body)
+}
+
+type UnionExample200JSONResponse struct {
+	Body struct {
+		union json.RawMessage
+	}
+	Headers UnionExample200ResponseHeaders
+}
+
+func (response UnionExample200JSONResponse) VisitUnionExampleResponse(ctx fiber.Ctx) error {
+	ctx.Response().Header.Set("header1", fmt.Sprint(response.Headers.Header1))
+	ctx.Response().Header.Set("header2", fmt.Sprint(response.Headers.Header2))
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response.Body.union)
+}
+
+type UnionExample400Response = BadrequestResponse
+
+func (response UnionExample400Response) VisitUnionExampleResponse(ctx fiber.Ctx) error {
+	ctx.Status(400)
+	return nil
+}
+
+type UnionExampledefaultResponse struct {
+	StatusCode int
+}
+
+func (response UnionExampledefaultResponse) VisitUnionExampleResponse(ctx fiber.Ctx) error {
+	ctx.Status(response.StatusCode)
+	return nil
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+
+	// (POST /json)
+	JSONExample(ctx context.Context, request JSONExampleRequestObject) (JSONExampleResponseObject, error)
+
+	// (POST /multipart)
+	MultipartExample(ctx context.Context, request MultipartExampleRequestObject) (MultipartExampleResponseObject, error)
+
+	// (POST /multipart-related)
+	MultipartRelatedExample(ctx context.Context, request MultipartRelatedExampleRequestObject) (MultipartRelatedExampleResponseObject, error)
+
+	// (POST /multiple)
+	MultipleRequestAndResponseTypes(ctx context.Context, request MultipleRequestAndResponseTypesRequestObject) (MultipleRequestAndResponseTypesResponseObject, error)
+
+	// (GET /reserved-go-keyword-parameters/{type})
+	ReservedGoKeywordParameters(ctx context.Context, request ReservedGoKeywordParametersRequestObject) (ReservedGoKeywordParametersResponseObject, error)
+
+	// (POST /reusable-responses)
+	ReusableResponses(ctx context.Context, request ReusableResponsesRequestObject) (ReusableResponsesResponseObject, error)
+
+	// (POST /text)
+	TextExample(ctx context.Context, request TextExampleRequestObject) (TextExampleResponseObject, error)
+
+	// (POST /unknown)
+	UnknownExample(ctx context.Context, request UnknownExampleRequestObject) (UnknownExampleResponseObject, error)
+
+	// (POST /unspecified-content-type)
+	UnspecifiedContentType(ctx context.Context, request UnspecifiedContentTypeRequestObject) (UnspecifiedContentTypeResponseObject, error)
+
+	// (POST /urlencoded)
+	URLEncodedExample(ctx context.Context, request URLEncodedExampleRequestObject) (URLEncodedExampleResponseObject, error)
+
+	// (POST /with-headers)
+	HeadersExample(ctx context.Context, request HeadersExampleRequestObject) (HeadersExampleResponseObject, error)
+
+	// (POST /with-union)
+	UnionExample(ctx context.Context, request UnionExampleRequestObject) (UnionExampleResponseObject, error)
+}
+
+type StrictHandlerFunc func(ctx fiber.Ctx, args interface{}) (interface{}, error)
+
+type StrictMiddlewareFunc func(f StrictHandlerFunc, operationID string) StrictHandlerFunc
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+}
+
+// JSONExample operation middleware
+func (sh *strictHandler) JSONExample(ctx fiber.Ctx) error {
+	var request JSONExampleRequestObject
+
+	var body JSONExampleJSONRequestBody
+	if err := ctx.Bind().Body(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.JSONExample(ctx.Context(), request.(JSONExampleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "JSONExample")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(JSONExampleResponseObject); ok {
+		if err := validResponse.VisitJSONExampleResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// MultipartExample operation middleware
+func (sh *strictHandler) MultipartExample(ctx fiber.Ctx) error {
+	var request MultipartExampleRequestObject
+
+	request.Body = multipart.NewReader(bytes.NewReader(ctx.Request().Body()), string(ctx.Request().Header.MultipartFormBoundary()))
+
+	handler := func(ctx fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.MultipartExample(ctx.Context(), request.(MultipartExampleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "MultipartExample")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(MultipartExampleResponseObject); ok {
+		if err := validResponse.VisitMultipartExampleResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// MultipartRelatedExample operation middleware
+func (sh *strictHandler) MultipartRelatedExample(ctx fiber.Ctx) error {
+	var request MultipartRelatedExampleRequestObject
+
+	if _, params, err := mime.ParseMediaType(string(ctx.Request().Header.ContentType())); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if boundary := params["boundary"]; boundary == "" {
+		return fiber.NewError(fiber.StatusBadRequest, http.ErrMissingBoundary.Error())
+	} else {
+		request.Body = multipart.NewReader(bytes.NewReader(ctx.Request().Body()), boundary)
+	}
+
+	handler := func(ctx fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.MultipartRelatedExample(ctx.Context(), request.(MultipartRelatedExampleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "MultipartRelatedExample")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(MultipartRelatedExampleResponseObject); ok {
+		if err := validResponse.VisitMultipartRelatedExampleResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// MultipleRequestAndResponseTypes operation middleware
+func (sh *strictHandler) MultipleRequestAndResponseTypes(ctx fiber.Ctx) error {
+	var request MultipleRequestAndResponseTypesRequestObject
+
+	if strings.HasPrefix(string(ctx.Request().Header.ContentType()), "application/json") {
+
+		var body MultipleRequestAndResponseTypesJSONRequestBody
+		if err := ctx.Bind().Body(&body); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+		request.JSONBody = &body
+	}
+	if strings.HasPrefix(string(ctx.Request().Header.ContentType()), "application/x-www-form-urlencoded") {
+		var body MultipleRequestAndResponseTypesFormdataRequestBody
+		if err := ctx.Bind().Body(&body); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+		request.FormdataBody = &body
+	}
+	if strings.HasPrefix(string(ctx.Request().Header.ContentType()), "image/png") {
+		request.Body = bytes.NewReader(ctx.Request().Body())
+	}
+	if strings.HasPrefix(string(ctx.Request().Header.ContentType()), "multipart/form-data") {
+		request.MultipartBody = multipart.NewReader(bytes.NewReader(ctx.Request().Body()), string(ctx.Request().Header.MultipartFormBoundary()))
+	}
+	if strings.HasPrefix(string(ctx.Request().Header.ContentType()), "text/plain") {
+		data := ctx.Request().Body()
+		body := MultipleRequestAndResponseTypesTextRequestBody(data)
+		request.TextBody = &body
+	}
+
+	handler := func(ctx fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.MultipleRequestAndResponseTypes(ctx.Context(), request.(MultipleRequestAndResponseTypesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "MultipleRequestAndResponseTypes")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(MultipleRequestAndResponseTypesResponseObject); ok {
+		if err := validResponse.VisitMultipleRequestAndResponseTypesResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ReservedGoKeywordParameters operation middleware
+func (sh *strictHandler) ReservedGoKeywordParameters(ctx fiber.Ctx, pType string) error {
+	var request ReservedGoKeywordParametersRequestObject
+
+	request.Type = pType
+
+	handler := func(ctx fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.ReservedGoKeywordParameters(ctx.Context(), request.(ReservedGoKeywordParametersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ReservedGoKeywordParameters")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(ReservedGoKeywordParametersResponseObject); ok {
+		if err := validResponse.VisitReservedGoKeywordParametersResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ReusableResponses operation middleware
+func (sh *strictHandler) ReusableResponses(ctx fiber.Ctx) error {
+	var request ReusableResponsesRequestObject
+
+	var body ReusableResponsesJSONRequestBody
+	if err := ctx.Bind().Body(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.ReusableResponses(ctx.Context(), request.(ReusableResponsesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ReusableResponses")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(ReusableResponsesResponseObject); ok {
+		if err := validResponse.VisitReusableResponsesResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// TextExample operation middleware
+func (sh *strictHandler) TextExample(ctx fiber.Ctx) error {
+	var request TextExampleRequestObject
+
+	data := ctx.Request().Body()
+	body := TextExampleTextRequestBody(data)
+	request.Body = &body
+
+	handler := func(ctx fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.TextExample(ctx.Context(), request.(TextExampleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TextExample")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(TextExampleResponseObject); ok {
+		if err := validResponse.VisitTextExampleResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// UnknownExample operation middleware
+func (sh *strictHandler) UnknownExample(ctx fiber.Ctx) error {
+	var request UnknownExampleRequestObject
+
+	request.Body = bytes.NewReader(ctx.Request().Body())
+
+	handler := func(ctx fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.UnknownExample(ctx.Context(), request.(UnknownExampleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UnknownExample")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(UnknownExampleResponseObject); ok {
+		if err := validResponse.VisitUnknownExampleResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// UnspecifiedContentType operation middleware
+func (sh *strictHandler) UnspecifiedContentType(ctx fiber.Ctx) error {
+	var request UnspecifiedContentTypeRequestObject
+
+	request.ContentType = string(ctx.Request().Header.ContentType())
+
+	request.Body = bytes.NewReader(ctx.Request().Body())
+
+	handler := func(ctx fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.UnspecifiedContentType(ctx.Context(), request.(UnspecifiedContentTypeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UnspecifiedContentType")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(UnspecifiedContentTypeResponseObject); ok {
+		if err := validResponse.VisitUnspecifiedContentTypeResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// URLEncodedExample operation middleware
+func (sh *strictHandler) URLEncodedExample(ctx fiber.Ctx) error {
+	var request URLEncodedExampleRequestObject
+
+	var body URLEncodedExampleFormdataRequestBody
+	if err := ctx.Bind().Body(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.URLEncodedExample(ctx.Context(), request.(URLEncodedExampleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "URLEncodedExample")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(URLEncodedExampleResponseObject); ok {
+		if err := validResponse.VisitURLEncodedExampleResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// HeadersExample operation middleware
+func (sh *strictHandler) HeadersExample(ctx fiber.Ctx, params HeadersExampleParams) error {
+	var request HeadersExampleRequestObject
+
+	request.Params = params
+
+	var body HeadersExampleJSONRequestBody
+	if err := ctx.Bind().Body(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.HeadersExample(ctx.Context(), request.(HeadersExampleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "HeadersExample")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(HeadersExampleResponseObject); ok {
+		if err := validResponse.VisitHeadersExampleResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// UnionExample operation middleware
+func (sh *strictHandler) UnionExample(ctx fiber.Ctx) error {
+	var request UnionExampleRequestObject
+
+	var body UnionExampleJSONRequestBody
+	if err := ctx.Bind().Body(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.UnionExample(ctx.Context(), request.(UnionExampleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UnionExample")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(UnionExampleResponseObject); ok {
+		if err := validResponse.VisitUnionExampleResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// Base64 encoded, gzipped, json marshaled Swagger object
+var swaggerSpec = []string{
+
+	"H4sIAAAAAAAC/+xYS3PbNhD+K5htTylpyo5PvDWeTNqmrTuyfer4ABFLCQkJoMBStEaj/94BQb0sSpUS",
+	"PTqZ3CRyX/y+3cVip5Dp0miFihykU7DojFYOmz8DLiz+U6Ej/0+gy6w0JLWCFN5x0W/fzSKwWDk+KHCu",
+	"7uUzrQhVo8qNKWTGvWryyXn9KbhshCX3v360mEMKPyTLUJLw1iX4wktTIMxms+hVBPcfIYIRcoG2iTb8",
+	"vF63TRODkIIjK9UQvJEgdtMpJhXhEK335kXbILzAPI50CsZqg5ZkwGjMiwq7PbVP9OATZhS+QKpcb2J5",
+	"pxVxqRwTMs/RoiLWgse8DcdcZYy2hIINJsx7yIg5tGO0EAFJ8oHBw+pz1gbsIIIxWhccXV/1rnqeL21Q",
+	"cSMhhbfNowgMp1HzQQuCjO7i/beH+z+ZdIxXpEtOMuNFMWElt27ECxRMKtI+xCojdwWNJ9sQ/6totd+3",
+	"UPqsaRLonRaTUyRMk5cr6XzT650pL2cR3PZ622wsgkpWCqwxk/Oq6MD8SX1WulYMrdW2/bKkrAqShlta",
+	"5Wod7T/mIvtAvrCX5NqWseDET4T6sTxdGvjYYsEJxR4E9IPkYTysmD8pC1/j56IcFLgK/braw0jXjo10",
+	"zUgzgbxgtaQRmyu+arBSMc6cVMMC2TyoqJPMAttj72cl+u23PHobJ+9n0ZqVl7iu67gpoMoWqDItvozC",
+	"CGTJh5gYNVxX97Y5QQqDCSFEHQfckQo5AsIXSkzBpdp9ep+ppX9H+miFHcrVYjOViHio4884qbUVseGW",
+	"l0hoXTL13mfe8BA7SvmvhSTLuGIDZIqXKBjPCS37oFlr0m2UbL/1+0F/DCJLU83Is/iT/j0FD0kzBkEE",
+	"3gGkAZVQ19J60slWGO2A7fk/8/OrCJijGYbteM1Vdxuct6gFdBZz51tiF3Md+AVP/RWJywxtuzNu4/px",
+	"jjPIM7n96H/El73GriO2vnPX9qGAVeHhdsxarX1g+8JOugeKYylQJ6W5PdDyxUB1BjOZSxRx+xVxiG1b",
+	"S7jTKrNI6yOQv9IpTWxhzN80aYQsIBAxp1mNrKwcMcOdY5KaLlLIcFsVuNE8npaR3QVPjxOzD6tvTsTp",
+	"m0sxetu7Plzl7YnzZm2U2VKP/d/fB5lD7+xHm5kOnPiO5/dC5ewvKfHKUqu7hH8JAsszPUM59hOREswi",
+	"VVahYGPJ54uYjdpsDSxp7ZqFQhjLaWi+YDtkIIp22rqBaNcS7vkbXhGdcnV5rjytlNy1Knzyr1k7Q78+",
+	"G6RW/9NFIC8IreIkx/jTcW6Qm1a0wvu8qbRXLEd7enj+9rJqFkHYXYcWVNkCUhgRmTRJws77ytV8OER7",
+	"JXXCjfQo/DsAiH2qp8AYAAA=",
+}
+
+// GetSwagger returns the content of the embedded swagger specification file
+// or error if failed to decode
+func decodeSpec() ([]byte, error) {
+	zipped, err := base64.StdEncoding.DecodeString(strings.Join(swaggerSpec, ""))
+	if err != nil {
+		return nil, fmt.Errorf("error base64 decoding spec: %w", err)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(zipped))
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+	var buf bytes.Buffer
+	_, err = buf.ReadFrom(zr)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+var rawSpec = decodeSpecCached()
+
+// a naive cached of a decoded swagger spec
+func decodeSpecCached() func() ([]byte, error) {
+	data, err := decodeSpec()
+	return func() ([]byte, error) {
+		return data, err
+	}
+}
+
+// Constructs a synthetic filesystem for resolving external references when loading openapi specifications.
+func PathToRawSpec(pathToFile string) map[string]func() ([]byte, error) {
+	res := make(map[string]func() ([]byte, error))
+	if len(pathToFile) > 0 {
+		res[pathToFile] = rawSpec
+	}
+
+	return res
+}
+
+// GetSwagger returns the Swagger specification corresponding to the generated code
+// in this file. The external references of Swagger specification are resolved.
+// The logic of resolving external references is tightly connected to "import-mapping" feature.
+// Externally referenced files must be embedded in the corresponding golang packages.
+// Urls can be supported but this task was out of the scope.
+func GetSwagger() (swagger *openapi3.T, err error) {
+	resolvePath := PathToRawSpec("")
+
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+	loader.ReadFromURIFunc = func(loader *openapi3.Loader, url *url.URL) ([]byte, error) {
+		pathToFile := url.String()
+		pathToFile = path.Clean(pathToFile)
+		getSpec, ok := resolvePath[pathToFile]
+		if !ok {
+			err1 := fmt.Errorf("path not found: %s", pathToFile)
+			return nil, err1
+		}
+		return getSpec()
+	}
+	var specData []byte
+	specData, err = rawSpec()
+	if err != nil {
+		return
+	}
+	swagger, err = loader.LoadFromData(specData)
+	if err != nil {
+		return
+	}
+	return
+}
@@ -11,19 +11,31 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/cloudwego/hertz/pkg/common/config"
+	"github.com/cloudwego/hertz/pkg/common/ut"
+	"github.com/cloudwego/hertz/pkg/route"
+	fasthttprouter "github.com/fasthttp/router"
 	"github.com/gin-gonic/gin"
 	"github.com/go-chi/chi/v5"
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/adaptor"
+	fiberv3 "github.com/gofiber/fiber/v3"
+	adaptorv3 "github.com/gofiber/fiber/v3/middleware/adaptor"
+	"github.com/julienschmidt/httprouter"
 	"github.com/kataras/iris/v12"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
+	"github.com/valyala/fasthttp"
 
 	chiAPI "github.com/oapi-codegen/oapi-codegen/v2/internal/test/strict-server/chi"
 	clientAPI "github.com/oapi-codegen/oapi-codegen/v2/internal/test/strict-server/client"
 	echoAPI "github.com/oapi-codegen/oapi-codegen/v2/internal/test/strict-server/echo"
+	fasthttpAPI "github.com/oapi-codegen/oapi-codegen/v2/internal/test/strict-server/fasthttp"
 	fiberAPI "github.com/oapi-codegen/oapi-codegen/v2/internal/test/strict-server/fiber"
+	fiberv3API "github.com/oapi-codegen/oapi-codegen/v2/internal/test/strict-server/fiberv3"
 	ginAPI "github.com/oapi-codegen/oapi-codegen/v2/internal/test/strict-server/gin"
+	hertzAPI "github.com/oapi-codegen/oapi-codegen/v2/internal/test/strict-server/hertz"
+	httprouterAPI "github.com/oapi-codegen/oapi-codegen/v2/internal/test/strict-server/httprouter"
 	irisAPI "github.com/oapi-codegen/oapi-codegen/v2/internal/test/strict-server/iris"
 
 	"github.com/oapi-codegen/runtime"
@@ -71,6 +83,83 @@ func TestFiberServer(t *testing.T) {
 	testImpl(t, adaptor.FiberApp(r))
 }
 
+func TestFiberV3Server(t *testing.T) {
+	server := fiberv3API.StrictServer{}
+	strictHandler := fiberv3API.NewStrictHandler(server, nil)
+	r := fiberv3.New()
+	fiberv3API.RegisterHandlers(r, strictHandler)
+	testImpl(t, adaptorv3.FiberApp(r))
+}
+
+func TestHertzServer(t *testing.T) {
+	server := hertzAPI.StrictServer{}
+	strictHandler := hertzAPI.NewStrictHandler(server, nil)
+	r := route.NewEngine(config.NewOptions(nil))
+	hertzAPI.RegisterHandlers(r, strictHandler)
+	testImpl(t, hertzHandler(r))
+}
+
+func TestHttprouterServer(t *testing.T) {
+	server := httprouterAPI.StrictServer{}
+	strictHandler := httprouterAPI.NewStrictHandler(server, nil)
+	handler := httprouterAPI.HandlerFromMux(strictHandler, httprouter.New())
+	testImpl(t, handler)
+}
+
+func TestFasthttpServer(t *testing.T) {
+	server := fasthttpAPI.StrictServer{}
+	strictHandler := fasthttpAPI.NewStrictHandler(server, nil)
+	handler := fasthttpAPI.HandlerFromMux(strictHandler, fasthttprouter.New())
+	testImpl(t, fasthttpHandler(handler))
+}
+
+// hertzHandler serves requests with the Hertz engine, without a network
+// connection, as Hertz doesn't implement http.Handler.
+func hertzHandler(engine *route.Engine) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		var headers []ut.Header
+		for key, values := range r.Header {
+			for _, value := range values {
+				headers = append(headers, ut.Header{Key: key, Value: value})
+			}
+		}
+		rsp := ut.PerformRequest(engine, r.Method, r.URL.RequestURI(), &ut.Body{Body: bytes.NewReader(body), Len: len(body)}, headers...).Result()
+		rsp.Header.VisitAll(func(key, value []byte) {
+			w.Header().Add(string(key), string(value))
+		})
+		w.WriteHeader(rsp.StatusCode())
+		_, _ = w.Write(rsp.Body())
+	})
+}
+
+// fasthttpHandler serves requests with the fasthttp handler, without a network
+// connection, as fasthttp doesn't implement http.Handler.
+func fasthttpHandler(handler fasthttp.RequestHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req fasthttp.Request
+		req.Header.SetMethod(r.Method)
+		req.SetRequestURI(r.URL.RequestURI())
+		for key, values := range r.Header {
+			for _, value := range values {
+				req.Header.Add(key, value)
+			}
+		}
+		body, _ := io.ReadAll(r.Body)
+		req.SetBody(body)
+
+		var ctx fasthttp.RequestCtx
+		ctx.Init(&req, nil, nil)
+		handler(&ctx)
+
+		ctx.Response.Header.VisitAll(func(key, value []byte) {
+			w.Header().Add(string(key), string(value))
+		})
+		w.WriteHeader(ctx.Response.StatusCode())
+		_, _ = w.Write(ctx.Response.Body())
+	})
+}
+
 func testImpl(t *testing.T, handler http.Handler) {
 	t.Run("JSONExample", func(t *testing.T) {
 		value := "123"
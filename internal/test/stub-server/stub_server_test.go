@@ -0,0 +1,106 @@
+package stubserver
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestClient(t *testing.T) *ClientWithResponses {
+	ts := httptest.NewServer(StubPreferMiddleware(Handler(NewStrictHandler(StubServer{}, nil))))
+	t.Cleanup(ts.Close)
+
+	client, err := NewClientWithResponses(ts.URL)
+	require.NoError(t, err)
+	return client
+}
+
+func preferExample(name string) RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("Prefer", "return=representation; example="+name)
+		return nil
+	}
+}
+
+func TestStubServerExamples(t *testing.T) {
+	client := newTestClient(t)
+
+	// The first example, by name, is the default
+	rsp, err := client.FindPetsWithResponse(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rsp.StatusCode())
+	require.NotNil(t, rsp.JSON200)
+	assert.Empty(t, *rsp.JSON200)
+
+	rsp, err = client.FindPetsWithResponse(context.Background(), preferExample("some"))
+	require.NoError(t, err)
+	require.NotNil(t, rsp.JSON200)
+	assert.Equal(t, []Pet{{Id: 1, Name: "Fido", Kind: Dog}, {Id: 2, Name: "Tom", Kind: Cat}}, *rsp.JSON200)
+
+	// An example of another response selects that response
+	rsp, err = client.FindPetsWithResponse(context.Background(), preferExample(`"broken"`))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusInternalServerError, rsp.StatusCode())
+	require.NotNil(t, rsp.JSONDefault)
+	assert.Equal(t, Error{Code: 500, Message: "Something broke"}, *rsp.JSONDefault)
+
+	// An unknown example is ignored
+	rsp, err = client.FindPetsWithResponse(context.Background(), preferExample("unknown"))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rsp.StatusCode())
+
+	getRsp, err := client.GetPetWithResponse(context.Background(), 7, preferExample("missing"))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, getRsp.StatusCode())
+	require.NotNil(t, getRsp.JSON404)
+	assert.Equal(t, "No such pet", getRsp.JSON404.Message)
+}
+
+func TestStubServerSingleExample(t *testing.T) {
+	client := newTestClient(t)
+
+	rsp, err := client.AddPetWithResponse(context.Background(), AddPetJSONRequestBody{Name: "Rex", Kind: Dog})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, rsp.StatusCode())
+	require.NotNil(t, rsp.JSON201)
+	assert.Equal(t, Pet{Id: 3, Name: "Rex", Kind: Dog}, *rsp.JSON201)
+}
+
+func TestStubServerSynthesized(t *testing.T) {
+	client := newTestClient(t)
+
+	// The values are synthesized from the schema, within its constraints
+	rsp, err := client.GetPetWithResponse(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rsp.StatusCode())
+	require.NotNil(t, rsp.JSON200)
+	assert.Equal(t, int64(1), rsp.JSON200.Id)
+	assert.Equal(t, "xxx", rsp.JSON200.Name)
+	assert.Equal(t, Cat, rsp.JSON200.Kind)
+	require.NotNil(t, rsp.JSON200.Born)
+	assert.Equal(t, "1970-01-01", rsp.JSON200.Born.String())
+	assert.Nil(t, rsp.JSON200.Parent)
+
+	healthRsp, err := client.HealthWithResponse(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, healthRsp.StatusCode())
+	assert.Equal(t, "ok", string(healthRsp.Body))
+}
+
+func TestStubServerNoContent(t *testing.T) {
+	client := newTestClient(t)
+
+	rsp, err := client.DeletePetWithResponse(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, rsp.StatusCode())
+}
+
+func TestWithStubExample(t *testing.T) {
+	rsp, err := StubServer{}.GetPet(WithStubExample(context.Background(), "missing"), GetPetRequestObject{Id: 1})
+	require.NoError(t, err)
+	assert.Equal(t, GetPet404JSONResponse{Code: 404, Message: "No such pet"}, rsp)
+}
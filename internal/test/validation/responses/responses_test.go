@@ -0,0 +1,76 @@
+package responses
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type strictServer struct {
+	pet Pet
+}
+
+func (s strictServer) AddPet(ctx context.Context, request AddPetRequestObject) (AddPetResponseObject, error) {
+	return AddPet201Response{}, nil
+}
+
+func (s strictServer) GetPet(ctx context.Context, request GetPetRequestObject) (GetPetResponseObject, error) {
+	return GetPet200JSONResponse(s.pet), nil
+}
+
+func newHandler(t *testing.T, pet Pet) (http.Handler, *[]*ResponseValidationError) {
+	t.Helper()
+	var errs []*ResponseValidationError
+	validator, err := NewStrictResponseValidator(ResponseValidatorOptions{
+		ErrorHandler: func(err *ResponseValidationError) {
+			errs = append(errs, err)
+		},
+	})
+	require.NoError(t, err)
+	return Handler(NewStrictHandler(strictServer{pet: pet}, []StrictMiddlewareFunc{validator})), &errs
+}
+
+func TestStrictResponseValidator(t *testing.T) {
+	h, errs := newHandler(t, Pet{Name: "Rex"})
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/pets/1", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"name":"Rex"}`, rec.Body.String())
+
+	req := httptest.NewRequest(http.MethodPost, "/pets", strings.NewReader(`{"name":"Rex"}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusCreated, rec.Code)
+
+	assert.Empty(t, *errs)
+}
+
+func TestStrictResponseValidatorInvalidResponse(t *testing.T) {
+	h, errs := newHandler(t, Pet{})
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/pets/1", nil))
+
+	// The response is still sent
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"name":""}`, rec.Body.String())
+
+	require.Len(t, *errs, 1)
+	err := (*errs)[0]
+	assert.Equal(t, "GetPet", err.OperationID)
+	assert.Equal(t, http.StatusOK, err.StatusCode)
+	assert.Equal(t, "application/json", err.ContentType)
+	assert.ErrorContains(t, err, "minimum string length is 1")
+}
+
+func TestStrictResponseValidatorErrorHandlerRequired(t *testing.T) {
+	_, err := NewStrictResponseValidator(ResponseValidatorOptions{})
+	assert.Error(t, err)
+}
@@ -0,0 +1,95 @@
+package validation
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type server struct{}
+
+func (server) AddPet(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusCreated)
+}
+
+func (server) GetPet(w http.ResponseWriter, r *http.Request, id int) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(Pet{Name: "Rex"})
+}
+
+func newHandler(t *testing.T, options RequestValidatorOptions) http.Handler {
+	t.Helper()
+	validator, err := RequestValidatorMiddleware(options)
+	require.NoError(t, err)
+
+	r := chi.NewRouter()
+	r.Use(validator)
+	return HandlerWithOptions(server{}, ChiServerOptions{BaseURL: options.BaseURL, BaseRouter: r})
+}
+
+func doRequest(h http.Handler, method, url, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, url, strings.NewReader(body))
+	if body != "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestRequestValidatorMiddleware(t *testing.T) {
+	h := newHandler(t, RequestValidatorOptions{})
+
+	rec := doRequest(h, http.MethodPost, "/pets", `{"name":"Rex"}`)
+	assert.Equal(t, http.StatusCreated, rec.Code)
+
+	rec = doRequest(h, http.MethodGet, "/pets/1", "")
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	rec = doRequest(h, http.MethodPost, "/pets", `{"name":""}`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	var body map[string]string
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&body))
+	assert.Contains(t, body["message"], "minimum string length is 1")
+
+	rec = doRequest(h, http.MethodGet, "/pets/0", "")
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec = doRequest(h, http.MethodGet, "/owners", "")
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec = doRequest(h, http.MethodDelete, "/pets/1", "")
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
+
+func TestRequestValidatorMiddlewareBaseURL(t *testing.T) {
+	h := newHandler(t, RequestValidatorOptions{BaseURL: "/v1"})
+
+	rec := doRequest(h, http.MethodGet, "/v1/pets/1", "")
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	rec = doRequest(h, http.MethodGet, "/v1/pets/0", "")
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestRequestValidatorMiddlewareErrorFormatter(t *testing.T) {
+	h := newHandler(t, RequestValidatorOptions{
+		ErrorFormatter: func(r *http.Request, err *RequestValidationError) (int, interface{}) {
+			return http.StatusUnprocessableEntity, map[string]interface{}{
+				"path":   r.URL.Path,
+				"status": err.StatusCode,
+			}
+		},
+	})
+
+	rec := doRequest(h, http.MethodPost, "/pets", `{}`)
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	assert.JSONEq(t, `{"path":"/pets","status":400}`, rec.Body.String())
+}
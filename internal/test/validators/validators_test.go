@@ -0,0 +1,33 @@
+package validators
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateMultipleOf(t *testing.T) {
+	// Multiples of fractions aren't exact integers when divided
+	for _, value := range []float64{0, 0.3, 1.1, 2.7, -0.7, 1000.3, 123456.7} {
+		assert.NoError(t, Measurement{Value: float32(min(value, 1000.3)), Ratio: value, Count: 9}.Validate(), "for %v", value)
+	}
+
+	err := Measurement{Value: 1.15, Ratio: 0.35, Count: 10}.Validate()
+	assert.EqualError(t, err, "/count: must be a multiple of 3; /ratio: must be a multiple of 0.1; /value: must be a multiple of 0.1")
+
+	err = Measurement{Value: 1000.4}.Validate()
+	assert.EqualError(t, err, "/value: must be <= 1000.3")
+}
+
+func TestValidateErrors(t *testing.T) {
+	tags := []string{"a", "a", "B"}
+	err := Measurement{Tags: &tags}.Validate()
+
+	var errs ValidationErrors
+	assert.ErrorAs(t, err, &errs)
+	assert.Equal(t, ValidationErrors{
+		{Path: "/tags", Message: "must have at most 2 items"},
+		{Path: "/tags", Message: "must not contain duplicate items"},
+		{Path: "/tags/2", Message: "must match the pattern \"^[a-z]+$\""},
+	}, errs)
+}
@@ -0,0 +1,986 @@
+// Package webhooks provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.0.0-00010101000000-000000000000 DO NOT EDIT.
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/oapi-codegen/runtime"
+	strictnethttp "github.com/oapi-codegen/runtime/strictmiddleware/nethttp"
+)
+
+// Error defines model for Error.
+type Error struct {
+	Message string `json:"message"`
+}
+
+// Event defines model for Event.
+type Event struct {
+	Kind string `json:"kind"`
+}
+
+// Pet defines model for Pet.
+type Pet struct {
+	Name string  `json:"name"`
+	Tag  *string `json:"tag"`
+}
+
+// Receipt defines model for Receipt.
+type Receipt struct {
+	Id *string `json:"id,omitempty"`
+}
+
+// Subscription defines model for Subscription.
+type Subscription struct {
+	CallbackUrl string `json:"callbackUrl"`
+}
+
+// EventId defines model for EventId.
+type EventId = string
+
+// NewPetWebhookParams defines parameters for NewPetWebhook.
+type NewPetWebhookParams struct {
+	Attempt    *int   `form:"attempt,omitempty" json:"attempt,omitempty"`
+	XSignature string `json:"X-Signature"`
+}
+
+// PostCreateSubscriptionOnEventParams defines parameters for PostCreateSubscriptionOnEvent.
+type PostCreateSubscriptionOnEventParams struct {
+	XEventId EventId `json:"X-Event-Id"`
+}
+
+// CreateSubscriptionJSONRequestBody defines body for CreateSubscription for application/json ContentType.
+type CreateSubscriptionJSONRequestBody = Subscription
+
+// NewPetWebhookJSONRequestBody defines body for NewPetWebhook for application/json ContentType.
+type NewPetWebhookJSONRequestBody = Pet
+
+// PostCreateSubscriptionOnEventJSONRequestBody defines body for PostCreateSubscriptionOnEvent for application/json ContentType.
+type PostCreateSubscriptionOnEventJSONRequestBody = Event
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// CreateSubscriptionWithBody request with any body
+	CreateSubscriptionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateSubscription(ctx context.Context, body CreateSubscriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) CreateSubscriptionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSubscriptionRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateSubscription(ctx context.Context, body CreateSubscriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSubscriptionRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewCreateSubscriptionRequest calls the generic CreateSubscription builder with application/json body
+func NewCreateSubscriptionRequest(server string, body CreateSubscriptionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateSubscriptionRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateSubscriptionRequestWithBody generates requests for CreateSubscription with any type of body
+func NewCreateSubscriptionRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/subscriptions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// CreateSubscriptionWithBodyWithResponse request with any body
+	CreateSubscriptionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSubscriptionResponse, error)
+
+	CreateSubscriptionWithResponse(ctx context.Context, body CreateSubscriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSubscriptionResponse, error)
+}
+
+type CreateSubscriptionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateSubscriptionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateSubscriptionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// CreateSubscriptionWithBodyWithResponse request with arbitrary body returning *CreateSubscriptionResponse
+func (c *ClientWithResponses) CreateSubscriptionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSubscriptionResponse, error) {
+	rsp, err := c.CreateSubscriptionWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSubscriptionResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateSubscriptionWithResponse(ctx context.Context, body CreateSubscriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSubscriptionResponse, error) {
+	rsp, err := c.CreateSubscription(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSubscriptionResponse(rsp)
+}
+
+// ParseCreateSubscriptionResponse parses an HTTP response from a CreateSubscriptionWithResponse call
+func ParseCreateSubscriptionResponse(rsp *http.Response) (*CreateSubscriptionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateSubscriptionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// WebhookClient sends the requests of the webhooks and callbacks in the
+// OpenAPI3 specification for this service to the URLs they're registered at.
+type WebhookClient struct {
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// WebhookClientOption allows setting custom parameters during construction
+type WebhookClientOption func(*WebhookClient) error
+
+// Creates a new WebhookClient, with reasonable defaults
+func NewWebhookClient(opts ...WebhookClientOption) (*WebhookClient, error) {
+	// create a client with sane default values
+	client := WebhookClient{}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithWebhookHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithWebhookHTTPClient(doer HttpRequestDoer) WebhookClientOption {
+	return func(c *WebhookClient) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithWebhookRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request,
+// for instance to sign it.
+func WithWebhookRequestEditorFn(fn RequestEditorFn) WebhookClientOption {
+	return func(c *WebhookClient) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the webhook client above.
+type WebhookClientInterface interface {
+	// NewPetWebhookWithBody request with any body (POST newPet)
+	NewPetWebhookWithBody(ctx context.Context, targetURL string, params *NewPetWebhookParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	NewPetWebhook(ctx context.Context, targetURL string, params *NewPetWebhookParams, body NewPetWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeletePetRemoved request (DELETE petRemoved)
+	DeletePetRemoved(ctx context.Context, targetURL string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostCreateSubscriptionOnEventWithBody request with any body (POST {$request.body#/callbackUrl})
+	PostCreateSubscriptionOnEventWithBody(ctx context.Context, targetURL string, params *PostCreateSubscriptionOnEventParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostCreateSubscriptionOnEvent(ctx context.Context, targetURL string, params *PostCreateSubscriptionOnEventParams, body PostCreateSubscriptionOnEventJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *WebhookClient) NewPetWebhookWithBody(ctx context.Context, targetURL string, params *NewPetWebhookParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewNewPetWebhookRequestWithBody(targetURL, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *WebhookClient) NewPetWebhook(ctx context.Context, targetURL string, params *NewPetWebhookParams, body NewPetWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewNewPetWebhookRequest(targetURL, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *WebhookClient) DeletePetRemoved(ctx context.Context, targetURL string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeletePetRemovedRequest(targetURL)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *WebhookClient) PostCreateSubscriptionOnEventWithBody(ctx context.Context, targetURL string, params *PostCreateSubscriptionOnEventParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostCreateSubscriptionOnEventRequestWithBody(targetURL, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *WebhookClient) PostCreateSubscriptionOnEvent(ctx context.Context, targetURL string, params *PostCreateSubscriptionOnEventParams, body PostCreateSubscriptionOnEventJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostCreateSubscriptionOnEventRequest(targetURL, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewNewPetWebhookRequest calls the generic NewPetWebhook builder with application/json body
+func NewNewPetWebhookRequest(targetURL string, params *NewPetWebhookParams, body NewPetWebhookJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewNewPetWebhookRequestWithBody(targetURL, params, "application/json", bodyReader)
+}
+
+// NewNewPetWebhookRequestWithBody generates NewPetWebhook requests to targetURL with any type of body
+func NewNewPetWebhookRequestWithBody(targetURL string, params *NewPetWebhookParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+	queryURL, err := url.Parse(targetURL)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Attempt != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "attempt", runtime.ParamLocationQuery, *params.Attempt); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Signature", runtime.ParamLocationHeader, params.XSignature)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Signature", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewDeletePetRemovedRequest generates DeletePetRemoved requests to targetURL
+func NewDeletePetRemovedRequest(targetURL string) (*http.Request, error) {
+	var err error
+	queryURL, err := url.Parse(targetURL)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostCreateSubscriptionOnEventRequest calls the generic PostCreateSubscriptionOnEvent builder with application/json body
+func NewPostCreateSubscriptionOnEventRequest(targetURL string, params *PostCreateSubscriptionOnEventParams, body PostCreateSubscriptionOnEventJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostCreateSubscriptionOnEventRequestWithBody(targetURL, params, "application/json", bodyReader)
+}
+
+// NewPostCreateSubscriptionOnEventRequestWithBody generates PostCreateSubscriptionOnEvent requests to targetURL with any type of body
+func NewPostCreateSubscriptionOnEventRequestWithBody(targetURL string, params *PostCreateSubscriptionOnEventParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+	queryURL, err := url.Parse(targetURL)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Event-Id", runtime.ParamLocationHeader, params.XEventId)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Event-Id", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+func (c *WebhookClient) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type ErrorJSONResponse Error
+
+// WebhookServerInterface represents all the handlers for the webhooks and
+// callbacks.
+type WebhookServerInterface interface {
+	// A new pet was added
+	// (POST newPet)
+	NewPetWebhook(w http.ResponseWriter, r *http.Request, params NewPetWebhookParams)
+
+	// (DELETE petRemoved)
+	DeletePetRemoved(w http.ResponseWriter, r *http.Request)
+
+	// (POST {$request.body#/callbackUrl})
+	PostCreateSubscriptionOnEvent(w http.ResponseWriter, r *http.Request, params PostCreateSubscriptionOnEventParams)
+}
+
+// WebhookServerInterfaceWrapper converts requests to parameters. Each of its
+// methods is an http.HandlerFunc, to be registered at the URL which the webhook
+// or callback is sent to.
+type WebhookServerInterfaceWrapper struct {
+	Handler            WebhookServerInterface
+	HandlerMiddlewares []WebhookMiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type WebhookMiddlewareFunc func(http.Handler) http.Handler
+
+// NewWebhookServerInterfaceWrapper wraps si, responding to requests with
+// invalid parameters with a 400 Bad Request.
+func NewWebhookServerInterfaceWrapper(si WebhookServerInterface, middlewares ...WebhookMiddlewareFunc) *WebhookServerInterfaceWrapper {
+	return &WebhookServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: middlewares,
+		ErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+	}
+}
+
+// NewPetWebhook webhook middleware
+func (siw *WebhookServerInterfaceWrapper) NewPetWebhook(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params NewPetWebhookParams
+
+	// ------------- Optional query parameter "attempt" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "attempt", r.URL.Query(), &params.Attempt)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "attempt", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Signature" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Signature")]; found {
+		var XSignature string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Signature", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Signature", valueList[0], &XSignature, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Signature", Err: err})
+			return
+		}
+
+		params.XSignature = XSignature
+
+	} else {
+		err := fmt.Errorf("Header parameter X-Signature is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "X-Signature", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.NewPetWebhook(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeletePetRemoved webhook middleware
+func (siw *WebhookServerInterfaceWrapper) DeletePetRemoved(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeletePetRemoved(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostCreateSubscriptionOnEvent webhook middleware
+func (siw *WebhookServerInterfaceWrapper) PostCreateSubscriptionOnEvent(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostCreateSubscriptionOnEventParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Event-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Event-Id")]; found {
+		var XEventId EventId
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Event-Id", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Event-Id", valueList[0], &XEventId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Event-Id", Err: err})
+			return
+		}
+
+		params.XEventId = XEventId
+
+	} else {
+		err := fmt.Errorf("Header parameter X-Event-Id is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "X-Event-Id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostCreateSubscriptionOnEvent(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+type NewPetWebhookRequestObject struct {
+	Params NewPetWebhookParams
+	Body   *NewPetWebhookJSONRequestBody
+}
+
+type NewPetWebhookResponseObject interface {
+	VisitNewPetWebhookResponse(w http.ResponseWriter) error
+}
+
+type NewPetWebhook200JSONResponse Receipt
+
+func (response NewPetWebhook200JSONResponse) VisitNewPetWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type NewPetWebhookdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response NewPetWebhookdefaultJSONResponse) VisitNewPetWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeletePetRemovedRequestObject struct {
+}
+
+type DeletePetRemovedResponseObject interface {
+	VisitDeletePetRemovedResponse(w http.ResponseWriter) error
+}
+
+type DeletePetRemoved204Response struct {
+}
+
+func (response DeletePetRemoved204Response) VisitDeletePetRemovedResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type PostCreateSubscriptionOnEventRequestObject struct {
+	Params PostCreateSubscriptionOnEventParams
+	Body   *PostCreateSubscriptionOnEventJSONRequestBody
+}
+
+type PostCreateSubscriptionOnEventResponseObject interface {
+	VisitPostCreateSubscriptionOnEventResponse(w http.ResponseWriter) error
+}
+
+type PostCreateSubscriptionOnEvent204Response struct {
+}
+
+func (response PostCreateSubscriptionOnEvent204Response) VisitPostCreateSubscriptionOnEventResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+// WebhookStrictServerInterface represents all the handlers for the webhooks
+// and callbacks.
+type WebhookStrictServerInterface interface {
+	// A new pet was added
+	// (POST newPet)
+	NewPetWebhook(ctx context.Context, request NewPetWebhookRequestObject) (NewPetWebhookResponseObject, error)
+
+	// (DELETE petRemoved)
+	DeletePetRemoved(ctx context.Context, request DeletePetRemovedRequestObject) (DeletePetRemovedResponseObject, error)
+
+	// (POST {$request.body#/callbackUrl})
+	PostCreateSubscriptionOnEvent(ctx context.Context, request PostCreateSubscriptionOnEventRequestObject) (PostCreateSubscriptionOnEventResponseObject, error)
+}
+
+type WebhookStrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewWebhookStrictHandler(ssi WebhookStrictServerInterface, middlewares []strictnethttp.StrictHTTPMiddlewareFunc) WebhookServerInterface {
+	return &webhookStrictHandler{ssi: ssi, middlewares: middlewares, options: WebhookStrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewWebhookStrictHandlerWithOptions(ssi WebhookStrictServerInterface, middlewares []strictnethttp.StrictHTTPMiddlewareFunc, options WebhookStrictHTTPServerOptions) WebhookServerInterface {
+	return &webhookStrictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type webhookStrictHandler struct {
+	ssi         WebhookStrictServerInterface
+	middlewares []strictnethttp.StrictHTTPMiddlewareFunc
+	options     WebhookStrictHTTPServerOptions
+}
+
+// NewPetWebhook webhook middleware
+func (sh *webhookStrictHandler) NewPetWebhook(w http.ResponseWriter, r *http.Request, params NewPetWebhookParams) {
+	var request NewPetWebhookRequestObject
+
+	request.Params = params
+
+	var body NewPetWebhookJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.NewPetWebhook(ctx, request.(NewPetWebhookRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "NewPetWebhook")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(NewPetWebhookResponseObject); ok {
+		if err := validResponse.VisitNewPetWebhookResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeletePetRemoved webhook middleware
+func (sh *webhookStrictHandler) DeletePetRemoved(w http.ResponseWriter, r *http.Request) {
+	var request DeletePetRemovedRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeletePetRemoved(ctx, request.(DeletePetRemovedRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeletePetRemoved")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeletePetRemovedResponseObject); ok {
+		if err := validResponse.VisitDeletePetRemovedResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostCreateSubscriptionOnEvent webhook middleware
+func (sh *webhookStrictHandler) PostCreateSubscriptionOnEvent(w http.ResponseWriter, r *http.Request, params PostCreateSubscriptionOnEventParams) {
+	var request PostCreateSubscriptionOnEventRequestObject
+
+	request.Params = params
+
+	var body PostCreateSubscriptionOnEventJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostCreateSubscriptionOnEvent(ctx, request.(PostCreateSubscriptionOnEventRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostCreateSubscriptionOnEvent")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostCreateSubscriptionOnEventResponseObject); ok {
+		if err := validResponse.VisitPostCreateSubscriptionOnEventResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
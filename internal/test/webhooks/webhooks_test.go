@@ -0,0 +1,86 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type strictWebhookServer struct {
+	pets   []Pet
+	events []string
+}
+
+func (s *strictWebhookServer) NewPetWebhook(ctx context.Context, request NewPetWebhookRequestObject) (NewPetWebhookResponseObject, error) {
+	if request.Params.XSignature != "signed" {
+		return NewPetWebhookdefaultJSONResponse{
+			Body:       Error{Message: "invalid signature"},
+			StatusCode: http.StatusUnauthorized,
+		}, nil
+	}
+	s.pets = append(s.pets, *request.Body)
+	id := request.Body.Name
+	return NewPetWebhook200JSONResponse{Id: &id}, nil
+}
+
+func (s *strictWebhookServer) DeletePetRemoved(ctx context.Context, request DeletePetRemovedRequestObject) (DeletePetRemovedResponseObject, error) {
+	return DeletePetRemoved204Response{}, nil
+}
+
+func (s *strictWebhookServer) PostCreateSubscriptionOnEvent(ctx context.Context, request PostCreateSubscriptionOnEventRequestObject) (PostCreateSubscriptionOnEventResponseObject, error) {
+	s.events = append(s.events, request.Params.XEventId+":"+request.Body.Kind)
+	return PostCreateSubscriptionOnEvent204Response{}, nil
+}
+
+func TestWebhookRoundTrip(t *testing.T) {
+	server := &strictWebhookServer{}
+	wrapper := NewWebhookServerInterfaceWrapper(NewWebhookStrictHandler(server, nil))
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/hooks/pets", wrapper.NewPetWebhook)
+	mux.HandleFunc("/hooks/events", wrapper.PostCreateSubscriptionOnEvent)
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	client, err := NewWebhookClient()
+	require.NoError(t, err)
+
+	rsp, err := client.NewPetWebhook(context.Background(), ts.URL+"/hooks/pets",
+		&NewPetWebhookParams{XSignature: "signed"}, NewPetWebhookJSONRequestBody{Name: "Rex"})
+	require.NoError(t, err)
+	defer rsp.Body.Close()
+	require.Equal(t, http.StatusOK, rsp.StatusCode)
+
+	var receipt Receipt
+	require.NoError(t, json.NewDecoder(rsp.Body).Decode(&receipt))
+	require.NotNil(t, receipt.Id)
+	assert.Equal(t, "Rex", *receipt.Id)
+	assert.Equal(t, []Pet{{Name: "Rex"}}, server.pets)
+
+	rsp, err = client.NewPetWebhook(context.Background(), ts.URL+"/hooks/pets",
+		&NewPetWebhookParams{XSignature: "forged"}, NewPetWebhookJSONRequestBody{Name: "Max"})
+	require.NoError(t, err)
+	defer rsp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, rsp.StatusCode)
+
+	rsp, err = client.PostCreateSubscriptionOnEvent(context.Background(), ts.URL+"/hooks/events",
+		&PostCreateSubscriptionOnEventParams{XEventId: "42"}, PostCreateSubscriptionOnEventJSONRequestBody{Kind: "created"})
+	require.NoError(t, err)
+	defer rsp.Body.Close()
+	assert.Equal(t, http.StatusNoContent, rsp.StatusCode)
+	assert.Equal(t, []string{"42:created"}, server.events)
+}
+
+func TestWebhookMissingParameter(t *testing.T) {
+	wrapper := NewWebhookServerInterfaceWrapper(NewWebhookStrictHandler(&strictWebhookServer{}, nil))
+
+	req := httptest.NewRequest(http.MethodPost, "/hooks/events", nil)
+	rec := httptest.NewRecorder()
+	wrapper.PostCreateSubscriptionOnEvent(rec, req)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
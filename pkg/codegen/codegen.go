@@ -41,14 +41,6 @@ import (
 //go:embed templates
 var templates embed.FS
 
-// globalState stores all global state. Please don't put global state anywhere
-// else so that we can easily track it.
-var globalState struct {
-	options       Configuration
-	spec          *openapi3.T
-	importMapping importMap
-}
-
 // goImport represents a go package to be imported in the generated code
 type goImport struct {
 	Name string // package name
@@ -71,6 +63,10 @@ type importMap map[string]goImport
 // We use `-` to indicate that this is a bit of a special case
 const importMappingCurrentPackage = "-"
 
+// importMappingLocalComponents is the key of the Import Mapping used for references to components within the current spec, when their types are generated in a different package to the operations, see GenerateTagPackages.
+// No external reference can use it, as it's the separator between the document and the path within it.
+const importMappingLocalComponents = "#"
+
 // GoImports returns a slice of go import statements
 func (im importMap) GoImports() []string {
 	goImports := make([]string, 0, len(im))
@@ -108,45 +104,172 @@ func constructImportMapping(importMapping map[string]string) importMap {
 	return result
 }
 
+// Names of the files produced by GenerateFiles, one per concern of the
+// generated code.
+const (
+	TypesFileName  = "types.gen.go"
+	ClientFileName = "client.gen.go"
+	ServerFileName = "server.gen.go"
+	SpecFileName   = "spec.gen.go"
+	MocksFileName  = "mocks.gen.go"
+)
+
+// generatedCode holds each concern of the generated code separately, before
+// it's assembled into one or more files.
+type generatedCode struct {
+	t *template.Template
+
+	constants string
+	types     string
+	client    string
+	server    string
+	spec      string
+	mocks     string
+
+	// typeImports are the imports required by the type definitions
+	typeImports map[string]goImport
+	// operationImports are the imports required by the operations, which are
+	// used by the types, client and server code alike
+	operationImports map[string]goImport
+}
+
 // Generate uses the Go templating engine to generate all of our server wrappers from
 // the descriptions we've built up above from the schema objects.
 // opts defines
+//
+// With the spec handler, the generated code embeds the files returned by
+// SpecDocsFiles, which must be written next to it.
 func Generate(spec *openapi3.T, opts Configuration) (string, error) {
-	// This is global state
-	globalState.options = opts
-	globalState.spec = spec
-	globalState.importMapping = constructImportMapping(opts.ImportMapping)
+	g, err := NewGenerator(spec, opts)
+	if err != nil {
+		return "", err
+	}
+	return g.Generate()
+}
+
+// Generate generates the code for the Generator's spec, as a single file.
+func (g *Generator) Generate() (string, error) {
+	code, err := g.generateCode()
+	if err != nil {
+		return "", err
+	}
+
+	xGoTypeImports := map[string]goImport{}
+	MergeImports(xGoTypeImports, code.operationImports)
+	MergeImports(xGoTypeImports, code.typeImports)
+
+	return g.assembleFile(code.t, g.opts.PackageName+".go", xGoTypeImports,
+		code.constants, code.types, code.client, code.server, code.mocks, code.spec)
+}
+
+// GenerateFiles is like Generate, but rather than producing one single file, it
+// splits the generated code by concern, returning a map of file name to file
+// contents. Only files which have any content are returned, and each file only
+// imports what it needs. With the spec handler, the assets of its docs page are
+// returned too, as described by SpecDocsFiles.
+func GenerateFiles(spec *openapi3.T, opts Configuration) (map[string]string, error) {
+	g, err := NewGenerator(spec, opts)
+	if err != nil {
+		return nil, err
+	}
+	return g.GenerateFiles()
+}
+
+// GenerateFiles generates the code for the Generator's spec, split across
+// files as described by the package level GenerateFiles.
+func (g *Generator) GenerateFiles() (map[string]string, error) {
+	code, err := g.generateCode()
+	if err != nil {
+		return nil, err
+	}
+
+	return g.assembleFiles(code)
+}
+
+// assembleFiles splits the generated code by concern, as described by
+// GenerateFiles.
+func (g *Generator) assembleFiles(code *generatedCode) (map[string]string, error) {
+	typeImports := map[string]goImport{}
+	MergeImports(typeImports, code.operationImports)
+	MergeImports(typeImports, code.typeImports)
+
+	files := []struct {
+		name     string
+		imprts   map[string]goImport
+		sections []string
+	}{
+		{TypesFileName, typeImports, []string{code.constants, code.types}},
+		{ClientFileName, code.operationImports, []string{code.client}},
+		{ServerFileName, code.operationImports, []string{code.server}},
+		{SpecFileName, nil, []string{code.spec}},
+		{MocksFileName, code.operationImports, []string{code.mocks}},
+	}
+
+	out := make(map[string]string, len(files))
+	for _, f := range files {
+		if strings.TrimSpace(strings.Join(f.sections, "")) == "" {
+			continue
+		}
+		fileCode, err := g.assembleFile(code.t, f.name, f.imprts, f.sections...)
+		if err != nil {
+			return nil, fmt.Errorf("error generating %s: %w", f.name, err)
+		}
+		out[f.name] = fileCode
+	}
+
+	// The spec handler embeds the assets of its docs page from the
+	// directory of the generated code.
+	if g.opts.Generate.SpecHandler {
+		docs, err := SpecDocsFiles()
+		if err != nil {
+			return nil, err
+		}
+		for name, content := range docs {
+			out[name] = content
+		}
+	}
+	return out, nil
+}
+
+// prepareSpec normalizes the spec, and then filters and prunes it according to
+// the Configuration, leaving the parts of it which code is generated for.
+func prepareSpec(spec *openapi3.T, opts Configuration) error {
+	if err := normalizeOpenAPI31(spec); err != nil {
+		return fmt.Errorf("error normalizing OpenAPI 3.1 spec: %w", err)
+	}
+	if err := decodeStreamItemSchemas(spec); err != nil {
+		return fmt.Errorf("error decoding streamed responses: %w", err)
+	}
 
 	filterOperationsByTag(spec, opts)
 	filterOperationsByOperationID(spec, opts)
 	if !opts.OutputOptions.SkipPrune {
 		pruneUnusedComponents(spec)
 	}
+	return nil
+}
 
-	// if we are provided an override for the response type suffix update it
-	if opts.OutputOptions.ResponseTypeSuffix != "" {
-		responseTypeSuffix = opts.OutputOptions.ResponseTypeSuffix
-	}
+// generateCode runs all the generators enabled in the Configuration over the
+// spec, and returns their output without any imports, ready to be assembled
+// into files.
+func (g *Generator) generateCode() (*generatedCode, error) {
+	spec, opts := g.spec, g.opts
 
-	if globalState.options.OutputOptions.ClientTypeName == "" {
-		globalState.options.OutputOptions.ClientTypeName = defaultClientTypeName
+	if err := prepareSpec(spec, opts); err != nil {
+		return nil, err
 	}
 
-	nameNormalizerFunction := NameNormalizerFunction(opts.OutputOptions.NameNormalizer)
-	nameNormalizer = NameNormalizers[nameNormalizerFunction]
-	if nameNormalizer == nil {
-		return "", fmt.Errorf(`the name-normalizer option %v could not be found among options %q`,
-			opts.OutputOptions.NameNormalizer, NameNormalizers.Options())
+	if opts.OutputOptions.GenerateReadWriteVariants {
+		g.variants = readWriteVariantSchemas(spec, opts.OutputOptions.ExcludeSchemas)
 	}
 
 	// This creates the golang templates text package
-	TemplateFunctions["opts"] = func() Configuration { return globalState.options }
-	t := template.New("oapi-codegen").Funcs(TemplateFunctions)
+	t := template.New("oapi-codegen").Funcs(g.templateFunctions())
 	// This parses all of our own template files into the template object
 	// above
 	err := LoadTemplates(templates, t)
 	if err != nil {
-		return "", fmt.Errorf("error parsing oapi-codegen templates: %w", err)
+		return nil, fmt.Errorf("error parsing oapi-codegen templates: %w", err)
 	}
 
 	// load user-provided templates. Will Override built-in versions.
@@ -155,49 +278,70 @@ func Generate(spec *openapi3.T, opts Configuration) (string, error) {
 
 		txt, err := GetUserTemplateText(template)
 		if err != nil {
-			return "", fmt.Errorf("error loading user-provided template %q: %w", name, err)
+			return nil, fmt.Errorf("error loading user-provided template %q: %w", name, err)
 		}
 
 		_, err = utpl.Parse(txt)
 		if err != nil {
-			return "", fmt.Errorf("error parsing user-provided template %q: %w", name, err)
+			return nil, fmt.Errorf("error parsing user-provided template %q: %w", name, err)
 		}
 	}
 
-	ops, err := OperationDefinitions(spec, opts.OutputOptions.InitialismOverrides)
+	ops, err := g.OperationDefinitions(spec, opts.OutputOptions.InitialismOverrides)
 	if err != nil {
-		return "", fmt.Errorf("error creating operation definitions: %w", err)
+		return nil, fmt.Errorf("error creating operation definitions: %w", err)
+	}
+
+	var webhookOps []OperationDefinition
+	if opts.Generate.WebhookClient || opts.Generate.WebhookServer {
+		webhookOps, err = g.WebhookOperationDefinitions(spec, opts.OutputOptions.InitialismOverrides)
+		if err != nil {
+			return nil, fmt.Errorf("error creating webhook operation definitions: %w", err)
+		}
+		if err := checkWebhookOperationIDs(ops, webhookOps); err != nil {
+			return nil, err
+		}
 	}
+	// The types of the webhooks and callbacks are generated alongside those of
+	// the operations of the paths.
+	allOps := append(append([]OperationDefinition{}, ops...), webhookOps...)
+
+	code := &generatedCode{t: t}
 
-	xGoTypeImports, err := OperationImports(ops)
+	code.operationImports, err = OperationImports(allOps)
 	if err != nil {
-		return "", fmt.Errorf("error getting operation imports: %w", err)
+		return nil, fmt.Errorf("error getting operation imports: %w", err)
 	}
 
-	var typeDefinitions, constantDefinitions string
 	if opts.Generate.Models {
-		typeDefinitions, err = GenerateTypeDefinitions(t, spec, ops, opts.OutputOptions.ExcludeSchemas)
+		typesSpec := spec
+		if _, ok := g.importMapping[importMappingLocalComponents]; ok {
+			withoutComponents := *spec
+			withoutComponents.Components = nil
+			typesSpec = &withoutComponents
+		}
+
+		code.types, err = g.GenerateTypeDefinitions(t, typesSpec, allOps, opts.OutputOptions.ExcludeSchemas)
 		if err != nil {
-			return "", fmt.Errorf("error generating type definitions: %w", err)
+			return nil, fmt.Errorf("error generating type definitions: %w", err)
 		}
 
-		constantDefinitions, err = GenerateConstants(t, ops)
+		code.constants, err = GenerateConstants(t, ops)
 		if err != nil {
-			return "", fmt.Errorf("error generating constants: %w", err)
+			return nil, fmt.Errorf("error generating constants: %w", err)
 		}
 
-		imprts, err := GetTypeDefinitionsImports(spec, opts.OutputOptions.ExcludeSchemas)
+		code.typeImports, err = GetTypeDefinitionsImports(typesSpec, opts.OutputOptions.ExcludeSchemas)
 		if err != nil {
-			return "", fmt.Errorf("error getting type definition imports: %w", err)
+			return nil, fmt.Errorf("error getting type definition imports: %w", err)
 		}
-		MergeImports(xGoTypeImports, imprts)
 	}
 
 	var irisServerOut string
 	if opts.Generate.IrisServer {
 		irisServerOut, err = GenerateIrisServer(t, ops)
 		if err != nil {
-			return "", fmt.Errorf("error generating Go handlers for Paths: %w", err)
+			return nil, fmt.Errorf("error generating Go handlers for Paths: %w", err)
 		}
 	}
 
@@ -205,7 +349,7 @@ func Generate(spec *openapi3.T, opts Configuration) (string, error) {
 	if opts.Generate.EchoServer {
 		echoServerOut, err = GenerateEchoServer(t, ops)
 		if err != nil {
-			return "", fmt.Errorf("error generating Go handlers for Paths: %w", err)
+			return nil, fmt.Errorf("error generating Go handlers for Paths: %w", err)
 		}
 	}
 
@@ -213,7 +357,7 @@ func Generate(spec *openapi3.T, opts Configuration) (string, error) {
 	if opts.Generate.ChiServer {
 		chiServerOut, err = GenerateChiServer(t, ops)
 		if err != nil {
-			return "", fmt.Errorf("error generating Go handlers for Paths: %w", err)
+			return nil, fmt.Errorf("error generating Go handlers for Paths: %w", err)
 		}
 	}
 
@@ -221,7 +365,15 @@ func Generate(spec *openapi3.T, opts Configuration) (string, error) {
 	if opts.Generate.FiberServer {
 		fiberServerOut, err = GenerateFiberServer(t, ops)
 		if err != nil {
-			return "", fmt.Errorf("error generating Go handlers for Paths: %w", err)
+			return nil, fmt.Errorf("error generating Go handlers for Paths: %w", err)
+		}
+	}
+
+	var fiberV3ServerOut string
+	if opts.Generate.FiberV3Server {
+		fiberV3ServerOut, err = GenerateFiberV3Server(t, ops)
+		if err != nil {
+			return nil, fmt.Errorf("error generating Go handlers for Paths: %w", err)
 		}
 	}
 
@@ -229,7 +381,15 @@ func Generate(spec *openapi3.T, opts Configuration) (string, error) {
 	if opts.Generate.GinServer {
 		ginServerOut, err = GenerateGinServer(t, ops)
 		if err != nil {
-			return "", fmt.Errorf("error generating Go handlers for Paths: %w", err)
+			return nil, fmt.Errorf("error generating Go handlers for Paths: %w", err)
+		}
+	}
+
+	var hertzServerOut string
+	if opts.Generate.HertzServer {
+		hertzServerOut, err = GenerateHertzServer(t, ops)
+		if err != nil {
+			return nil, fmt.Errorf("error generating Go handlers for Paths: %w", err)
 		}
 	}
 
@@ -237,7 +397,7 @@ func Generate(spec *openapi3.T, opts Configuration) (string, error) {
 	if opts.Generate.GorillaServer {
 		gorillaServerOut, err = GenerateGorillaServer(t, ops)
 		if err != nil {
-			return "", fmt.Errorf("error generating Go handlers for Paths: %w", err)
+			return nil, fmt.Errorf("error generating Go handlers for Paths: %w", err)
 		}
 	}
 
@@ -245,7 +405,31 @@ func Generate(spec *openapi3.T, opts Configuration) (string, error) {
 	if opts.Generate.StdHTTPServer {
 		stdHTTPServerOut, err = GenerateStdHTTPServer(t, ops)
 		if err != nil {
-			return "", fmt.Errorf("error generating Go handlers for Paths: %w", err)
+			return nil, fmt.Errorf("error generating Go handlers for Paths: %w", err)
+		}
+	}
+
+	var httprouterServerOut string
+	if opts.Generate.HttprouterServer {
+		httprouterServerOut, err = GenerateHttprouterServer(t, ops)
+		if err != nil {
+			return nil, fmt.Errorf("error generating Go handlers for Paths: %w", err)
+		}
+	}
+
+	var fasthttpServerOut string
+	if opts.Generate.FasthttpServer {
+		fasthttpServerOut, err = GenerateFasthttpServer(t, ops)
+		if err != nil {
+			return nil, fmt.Errorf("error generating Go handlers for Paths: %w", err)
+		}
+	}
+
+	var securityHandlerOut string
+	if opts.Generate.SecurityHandler {
+		securityHandlerOut, err = g.GenerateSecurityHandler(t, spec, ops)
+		if err != nil {
+			return nil, fmt.Errorf("error generating security handler: %w", err)
 		}
 	}
 
@@ -253,147 +437,148 @@ func Generate(spec *openapi3.T, opts Configuration) (string, error) {
 	if opts.Generate.Strict {
 		var responses []ResponseDefinition
 		if spec.Components != nil {
-			responses, err = GenerateResponseDefinitions("", spec.Components.Responses)
+			responses, err = g.GenerateResponseDefinitions("", spec.Components.Responses)
 			if err != nil {
-				return "", fmt.Errorf("error generation response definitions for schema: %w", err)
+				return nil, fmt.Errorf("error generation response definitions for schema: %w", err)
 			}
 		}
 		strictServerResponses, err := GenerateStrictResponses(t, responses)
 		if err != nil {
-			return "", fmt.Errorf("error generation response definitions for schema: %w", err)
+			return nil, fmt.Errorf("error generation response definitions for schema: %w", err)
 		}
 		strictServerOut, err = GenerateStrictServer(t, ops, opts)
 		if err != nil {
-			return "", fmt.Errorf("error generating Go handlers for Paths: %w", err)
+			return nil, fmt.Errorf("error generating Go handlers for Paths: %w", err)
 		}
 		strictServerOut = strictServerResponses + strictServerOut
-	}
 
-	var clientOut string
-	if opts.Generate.Client {
-		clientOut, err = GenerateClient(t, ops)
-		if err != nil {
-			return "", fmt.Errorf("error generating client: %w", err)
+		if opts.Generate.StrictResponseValidation {
+			strictResponseValidationOut, err := GenerateStrictResponseValidation(t, ops, opts)
+			if err != nil {
+				return nil, fmt.Errorf("error generating strict response validation: %w", err)
+			}
+			strictServerOut += strictResponseValidationOut
 		}
-	}
 
-	var clientWithResponsesOut string
-	if opts.Generate.Client {
-		clientWithResponsesOut, err = GenerateClientWithResponses(t, ops)
-		if err != nil {
-			return "", fmt.Errorf("error generating client with responses: %w", err)
+		if opts.Generate.StubServer {
+			stubServerOut, err := g.GenerateStubServer(t, ops)
+			if err != nil {
+				return nil, fmt.Errorf("error generating stub server: %w", err)
+			}
+			strictServerOut += stubServerOut
 		}
 	}
 
-	var inlinedSpec string
-	if opts.Generate.EmbeddedSpec {
-		inlinedSpec, err = GenerateInlinedSpec(t, globalState.importMapping, spec)
+	var validationMiddlewareOut string
+	if opts.Generate.ValidationMiddleware {
+		validationMiddlewareOut, err = GenerateValidationMiddleware(t, opts)
 		if err != nil {
-			return "", fmt.Errorf("error generating Go handlers for Paths: %w", err)
+			return nil, fmt.Errorf("error generating validation middleware: %w", err)
 		}
 	}
 
-	var buf bytes.Buffer
-	w := bufio.NewWriter(&buf)
-
-	externalImports := append(globalState.importMapping.GoImports(), importMap(xGoTypeImports).GoImports()...)
-	importsOut, err := GenerateImports(
-		t,
-		externalImports,
-		opts.PackageName,
-		opts.NoVCSVersionOverride,
-	)
-	if err != nil {
-		return "", fmt.Errorf("error generating imports: %w", err)
-	}
-
-	_, err = w.WriteString(importsOut)
-	if err != nil {
-		return "", fmt.Errorf("error writing imports: %w", err)
-	}
-
-	_, err = w.WriteString(constantDefinitions)
-	if err != nil {
-		return "", fmt.Errorf("error writing constants: %w", err)
+	var webhookServerOut string
+	if opts.Generate.WebhookServer {
+		webhookServerOut, err = GenerateWebhookServer(t, webhookOps, opts)
+		if err != nil {
+			return nil, fmt.Errorf("error generating Go handlers for webhooks: %w", err)
+		}
 	}
 
-	_, err = w.WriteString(typeDefinitions)
-	if err != nil {
-		return "", fmt.Errorf("error writing type definitions: %w", err)
-	}
+	code.server = irisServerOut + echoServerOut + chiServerOut + fiberServerOut + fiberV3ServerOut +
+		ginServerOut + hertzServerOut + gorillaServerOut + stdHTTPServerOut + httprouterServerOut + fasthttpServerOut +
+		securityHandlerOut + strictServerOut + validationMiddlewareOut + webhookServerOut
 
 	if opts.Generate.Client {
-		_, err = w.WriteString(clientOut)
-		if err != nil {
-			return "", fmt.Errorf("error writing client: %w", err)
-		}
-		_, err = w.WriteString(clientWithResponsesOut)
+		clientOut, err := GenerateClient(t, ops)
 		if err != nil {
-			return "", fmt.Errorf("error writing client: %w", err)
+			return nil, fmt.Errorf("error generating client: %w", err)
 		}
-	}
 
-	if opts.Generate.IrisServer {
-		_, err = w.WriteString(irisServerOut)
+		clientWithResponsesOut, err := GenerateClientWithResponses(t, ops)
 		if err != nil {
-			return "", fmt.Errorf("error writing server path handlers: %w", err)
+			return nil, fmt.Errorf("error generating client with responses: %w", err)
 		}
-
+		code.client = clientOut + clientWithResponsesOut
 	}
 
-	if opts.Generate.EchoServer {
-		_, err = w.WriteString(echoServerOut)
+	if opts.Generate.WebhookClient {
+		webhookClientOut, err := GenerateWebhookClient(t, webhookOps)
 		if err != nil {
-			return "", fmt.Errorf("error writing server path handlers: %w", err)
+			return nil, fmt.Errorf("error generating webhook client: %w", err)
 		}
+		code.client += webhookClientOut
 	}
 
-	if opts.Generate.ChiServer {
-		_, err = w.WriteString(chiServerOut)
+	if opts.Generate.Mocks {
+		code.mocks, err = g.GenerateMocks(t, ops)
 		if err != nil {
-			return "", fmt.Errorf("error writing server path handlers: %w", err)
+			return nil, fmt.Errorf("error generating mocks: %w", err)
 		}
 	}
 
-	if opts.Generate.FiberServer {
-		_, err = w.WriteString(fiberServerOut)
+	if opts.Generate.EmbeddedSpec {
+		// The embedded spec is self-contained, so only needs the packages
+		// of external references to resolve them
+		code.spec, err = GenerateInlinedSpec(t, constructImportMapping(opts.ImportMapping), spec)
 		if err != nil {
-			return "", fmt.Errorf("error writing server path handlers: %w", err)
+			return nil, fmt.Errorf("error generating Go handlers for Paths: %w", err)
 		}
-	}
 
-	if opts.Generate.GinServer {
-		_, err = w.WriteString(ginServerOut)
-		if err != nil {
-			return "", fmt.Errorf("error writing server path handlers: %w", err)
+		if opts.Generate.SpecHandler {
+			specHandlerOut, err := GenerateSpecHandler(t, opts)
+			if err != nil {
+				return nil, fmt.Errorf("error generating spec handler: %w", err)
+			}
+			code.spec += specHandlerOut
 		}
 	}
 
-	if opts.Generate.GorillaServer {
-		_, err = w.WriteString(gorillaServerOut)
-		if err != nil {
-			return "", fmt.Errorf("error writing server path handlers: %w", err)
-		}
+	return code, nil
+}
+
+// assembleFile writes the package clause and imports, followed by each of the
+// given sections of code, and formats the result.
+func (g *Generator) assembleFile(t *template.Template, fileName string, xGoTypeImports map[string]goImport, sections ...string) (string, error) {
+	opts := g.opts
+
+	var buf bytes.Buffer
+	w := bufio.NewWriter(&buf)
+
+	externalImports := append(g.importMapping.GoImports(), importMap(xGoTypeImports).GoImports()...)
+	importsOut, err := g.GenerateImports(
+		t,
+		externalImports,
+		opts.PackageName,
+		opts.NoVCSVersionOverride,
+	)
+	if err != nil {
+		return "", fmt.Errorf("error generating imports: %w", err)
 	}
 
-	if opts.Generate.StdHTTPServer {
-		_, err = w.WriteString(stdHTTPServerOut)
+	// The packages of mapped types are imported too, unless they already are,
+	// as a package can't be imported twice under the same name.
+	if missing := missingImports(importsOut, opts.TypeMapping.goImports()); len(missing) > 0 {
+		importsOut, err = g.GenerateImports(
+			t,
+			append(externalImports, missing...),
+			opts.PackageName,
+			opts.NoVCSVersionOverride,
+		)
 		if err != nil {
-			return "", fmt.Errorf("error writing server path handlers: %w", err)
+			return "", fmt.Errorf("error generating imports: %w", err)
 		}
 	}
 
-	if opts.Generate.Strict {
-		_, err = w.WriteString(strictServerOut)
-		if err != nil {
-			return "", fmt.Errorf("error writing server path handlers: %w", err)
-		}
+	_, err = w.WriteString(importsOut)
+	if err != nil {
+		return "", fmt.Errorf("error writing imports: %w", err)
 	}
 
-	if opts.Generate.EmbeddedSpec {
-		_, err = w.WriteString(inlinedSpec)
+	for _, section := range sections {
+		_, err = w.WriteString(section)
 		if err != nil {
-			return "", fmt.Errorf("error writing inlined spec: %w", err)
+			return "", fmt.Errorf("error writing generated code: %w", err)
 		}
 	}
 
@@ -411,40 +596,52 @@ func Generate(spec *openapi3.T, opts Configuration) (string, error) {
 		return goCode, nil
 	}
 
-	outBytes, err := imports.Process(opts.PackageName+".go", []byte(goCode), nil)
+	outBytes, err := imports.Process(fileName, []byte(goCode), nil)
 	if err != nil {
 		return "", fmt.Errorf("error formatting Go code %s: %w", goCode, err)
 	}
 	return string(outBytes), nil
 }
 
+// GenerateTypeDefinitions calls Generator.GenerateTypeDefinitions using the default Configuration.
 func GenerateTypeDefinitions(t *template.Template, swagger *openapi3.T, ops []OperationDefinition, excludeSchemas []string) (string, error) {
+	return defaultGenerator.GenerateTypeDefinitions(t, swagger, ops, excludeSchemas)
+}
+
+// GenerateTypeDefinitions generates the type definitions for the components of
+// the swagger spec, and for the given operations.
+func (g *Generator) GenerateTypeDefinitions(t *template.Template, swagger *openapi3.T, ops []OperationDefinition, excludeSchemas []string) (string, error) {
 	var allTypes []TypeDefinition
 	if swagger.Components != nil {
-		schemaTypes, err := GenerateTypesForSchemas(t, swagger.Components.Schemas, excludeSchemas)
+		schemaTypes, err := g.GenerateTypesForSchemas(t, swagger.Components.Schemas, excludeSchemas)
 		if err != nil {
 			return "", fmt.Errorf("error generating Go types for component schemas: %w", err)
 		}
 
-		paramTypes, err := GenerateTypesForParameters(t, swagger.Components.Parameters)
+		paramTypes, err := g.GenerateTypesForParameters(t, swagger.Components.Parameters)
 		if err != nil {
 			return "", fmt.Errorf("error generating Go types for component parameters: %w", err)
 		}
 		allTypes = append(schemaTypes, paramTypes...)
 
-		responseTypes, err := GenerateTypesForResponses(t, swagger.Components.Responses)
+		responseTypes, err := g.GenerateTypesForResponses(t, swagger.Components.Responses)
 		if err != nil {
 			return "", fmt.Errorf("error generating Go types for component responses: %w", err)
 		}
 		allTypes = append(allTypes, responseTypes...)
 
-		bodyTypes, err := GenerateTypesForRequestBodies(t, swagger.Components.RequestBodies)
+		bodyTypes, err := g.GenerateTypesForRequestBodies(t, swagger.Components.RequestBodies)
 		if err != nil {
 			return "", fmt.Errorf("error generating Go types for component request bodies: %w", err)
 		}
 		allTypes = append(allTypes, bodyTypes...)
 	}
 
+	sealedUnions, err := g.sealUnions(allTypes)
+	if err != nil {
+		return "", err
+	}
+
 	// Go through all operations, and add their types to allTypes, so that we can
 	// scan all of them for enums. Operation definitions are handled differently
 	// from the rest, so let's keep track of enumTypes separately, which will contain
@@ -459,7 +656,7 @@ func GenerateTypeDefinitions(t *template.Template, swagger *openapi3.T, ops []Op
 		return "", fmt.Errorf("error generating Go types for component request bodies: %w", err)
 	}
 
-	enumsOut, err := GenerateEnums(t, enumTypes)
+	enumsOut, err := g.GenerateEnums(t, enumTypes)
 	if err != nil {
 		return "", fmt.Errorf("error generating code for type enums: %w", err)
 	}
@@ -484,7 +681,34 @@ func GenerateTypeDefinitions(t *template.Template, swagger *openapi3.T, ops []Op
 		return "", fmt.Errorf("error generating boilerplate for union types with additionalProperties: %w", err)
 	}
 
-	typeDefinitions := strings.Join([]string{enumsOut, typesOut, operationsOut, allOfBoilerplate, unionBoilerplate, unionAndAdditionalBoilerplate}, "")
+	sealedUnionsOut, err := GenerateSealedUnions(t, sealedUnions)
+	if err != nil {
+		return "", fmt.Errorf("error generating sealed unions: %w", err)
+	}
+
+	var validatorsOut string
+	if g.opts.OutputOptions.GenerateValidators {
+		// enumTypes also contains the types within operations, which we want to
+		// validate too.
+		validatorsOut, err = g.GenerateValidators(t, enumTypes)
+		if err != nil {
+			return "", fmt.Errorf("error generating validators: %w", err)
+		}
+	}
+
+	if err := g.checkVariantConflicts(ops); err != nil {
+		return "", err
+	}
+
+	var defaultsOut string
+	if g.opts.OutputOptions.GenerateDefaults {
+		defaultsOut, err = g.GenerateDefaults(t, enumTypes, ops)
+		if err != nil {
+			return "", fmt.Errorf("error generating defaults: %w", err)
+		}
+	}
+
+	typeDefinitions := strings.Join([]string{enumsOut, typesOut, operationsOut, allOfBoilerplate, unionBoilerplate, unionAndAdditionalBoilerplate, sealedUnionsOut, validatorsOut, defaultsOut}, "")
 	return typeDefinitions, nil
 }
 
@@ -514,9 +738,40 @@ func GenerateConstants(t *template.Template, ops []OperationDefinition) (string,
 	return GenerateTemplates([]string{"constants.tmpl"}, t, constants)
 }
 
+// checkEnumHelperConflicts returns an error if the functions generated for any
+// of the enums have the same name as any of the types or enum values.
+func checkEnumHelperConflicts(enums []EnumDefinition, types []TypeDefinition) error {
+	names := map[string]bool{}
+	for _, tp := range types {
+		names[tp.TypeName] = true
+	}
+	for i := range enums {
+		for name := range enums[i].GetValues() {
+			names[name] = true
+		}
+	}
+
+	for i := range enums {
+		if !enums[i].HasHelpers() {
+			continue
+		}
+		for _, name := range enums[i].HelperNames() {
+			if names[name] {
+				return fmt.Errorf("the helper %s of enum %s conflicts with a type or enum value of the same name, unset `output-options.enum-helpers` to not generate enum helpers", name, enums[i].TypeName)
+			}
+		}
+	}
+	return nil
+}
+
+// GenerateTypesForSchemas calls Generator.GenerateTypesForSchemas using the default Configuration.
+func GenerateTypesForSchemas(t *template.Template, schemas map[string]*openapi3.SchemaRef, excludeSchemas []string) ([]TypeDefinition, error) {
+	return defaultGenerator.GenerateTypesForSchemas(t, schemas, excludeSchemas)
+}
+
 // GenerateTypesForSchemas generates type definitions for any custom types defined in the
 // components/schemas section of the Swagger spec.
-func GenerateTypesForSchemas(t *template.Template, schemas map[string]*openapi3.SchemaRef, excludeSchemas []string) ([]TypeDefinition, error) {
+func (g *Generator) GenerateTypesForSchemas(t *template.Template, schemas map[string]*openapi3.SchemaRef, excludeSchemas []string) ([]TypeDefinition, error) {
 	excludeSchemasMap := make(map[string]bool)
 	for _, schema := range excludeSchemas {
 		excludeSchemasMap[schema] = true
@@ -529,57 +784,71 @@ func GenerateTypesForSchemas(t *template.Template, schemas map[string]*openapi3.
 		}
 		schemaRef := schemas[schemaName]
 
-		goSchema, err := GenerateGoSchema(schemaRef, []string{schemaName})
+		goSchema, err := g.GenerateGoSchema(schemaRef, []string{schemaName})
 		if err != nil {
 			return nil, fmt.Errorf("error converting Schema %s to Go type: %w", schemaName, err)
 		}
 
-		goTypeName, err := renameSchema(schemaName, schemaRef)
+		goTypeName, err := g.renameSchema(schemaName, schemaRef)
 		if err != nil {
 			return nil, fmt.Errorf("error making name for components/schemas/%s: %w", schemaName, err)
 		}
 
-		types = append(types, TypeDefinition{
-			JsonName: schemaName,
-			TypeName: goTypeName,
-			Schema:   goSchema,
-		})
+		schemaTypes := append([]TypeDefinition{{
+			JsonName:  schemaName,
+			TypeName:  goTypeName,
+			Schema:    goSchema,
+			generator: g,
+		}}, goSchema.AdditionalTypes...)
+		types = append(types, schemaTypes...)
 
-		types = append(types, goSchema.AdditionalTypes...)
+		if g.variants[componentSchemasPrefix+schemaName] {
+			variantTypes, err := g.generateVariantTypes(schemaName, schemaRef, schemaTypes)
+			if err != nil {
+				return nil, fmt.Errorf("error generating variants of Schema %s: %w", schemaName, err)
+			}
+			types = append(types, variantTypes...)
+		}
 	}
 	return types, nil
 }
 
+// GenerateTypesForParameters calls Generator.GenerateTypesForParameters using the default Configuration.
+func GenerateTypesForParameters(t *template.Template, params map[string]*openapi3.ParameterRef) ([]TypeDefinition, error) {
+	return defaultGenerator.GenerateTypesForParameters(t, params)
+}
+
 // GenerateTypesForParameters generates type definitions for any custom types defined in the
 // components/parameters section of the Swagger spec.
-func GenerateTypesForParameters(t *template.Template, params map[string]*openapi3.ParameterRef) ([]TypeDefinition, error) {
+func (g *Generator) GenerateTypesForParameters(t *template.Template, params map[string]*openapi3.ParameterRef) ([]TypeDefinition, error) {
 	var types []TypeDefinition
 	for _, paramName := range SortedMapKeys(params) {
 		paramOrRef := params[paramName]
 
-		goType, err := paramToGoType(paramOrRef.Value, nil)
+		goType, err := g.paramToGoType(paramOrRef.Value, nil)
 		if err != nil {
 			return nil, fmt.Errorf("error generating Go type for schema in parameter %s: %w", paramName, err)
 		}
 
-		goTypeName, err := renameParameter(paramName, paramOrRef)
+		goTypeName, err := g.renameParameter(paramName, paramOrRef)
 		if err != nil {
 			return nil, fmt.Errorf("error making name for components/parameters/%s: %w", paramName, err)
 		}
 
 		typeDef := TypeDefinition{
-			JsonName: paramName,
-			Schema:   goType,
-			TypeName: goTypeName,
+			JsonName:  paramName,
+			Schema:    goType,
+			TypeName:  goTypeName,
+			generator: g,
 		}
 
 		if paramOrRef.Ref != "" {
 			// Generate a reference type for referenced parameters
-			refType, err := RefPathToGoType(paramOrRef.Ref)
+			refType, err := g.RefPathToGoType(paramOrRef.Ref)
 			if err != nil {
 				return nil, fmt.Errorf("error generating Go type for (%s) in parameter %s: %w", paramOrRef.Ref, paramName, err)
 			}
-			typeDef.TypeName = SchemaNameToTypeName(refType)
+			typeDef.TypeName = g.SchemaNameToTypeName(refType)
 		}
 
 		types = append(types, typeDef)
@@ -587,9 +856,14 @@ func GenerateTypesForParameters(t *template.Template, params map[string]*openapi
 	return types, nil
 }
 
+// GenerateTypesForResponses calls Generator.GenerateTypesForResponses using the default Configuration.
+func GenerateTypesForResponses(t *template.Template, responses openapi3.ResponseBodies) ([]TypeDefinition, error) {
+	return defaultGenerator.GenerateTypesForResponses(t, responses)
+}
+
 // GenerateTypesForResponses generates type definitions for any custom types defined in the
 // components/responses section of the Swagger spec.
-func GenerateTypesForResponses(t *template.Template, responses openapi3.ResponseBodies) ([]TypeDefinition, error) {
+func (g *Generator) GenerateTypesForResponses(t *template.Template, responses openapi3.ResponseBodies) ([]TypeDefinition, error) {
 	var types []TypeDefinition
 
 	for _, responseName := range SortedMapKeys(responses) {
@@ -614,29 +888,30 @@ func GenerateTypesForResponses(t *template.Template, responses openapi3.Response
 				continue
 			}
 
-			goType, err := GenerateGoSchema(response.Schema, []string{responseName})
+			goType, err := g.withVariant(responseVariant).GenerateGoSchema(response.Schema, []string{responseName})
 			if err != nil {
 				return nil, fmt.Errorf("error generating Go type for schema in response %s: %w", responseName, err)
 			}
 
-			goTypeName, err := renameResponse(responseName, responseOrRef)
+			goTypeName, err := g.renameResponse(responseName, responseOrRef)
 			if err != nil {
 				return nil, fmt.Errorf("error making name for components/responses/%s: %w", responseName, err)
 			}
 
 			typeDef := TypeDefinition{
-				JsonName: responseName,
-				Schema:   goType,
-				TypeName: goTypeName,
+				JsonName:  responseName,
+				Schema:    goType,
+				TypeName:  goTypeName,
+				generator: g,
 			}
 
 			if responseOrRef.Ref != "" {
 				// Generate a reference type for referenced parameters
-				refType, err := RefPathToGoType(responseOrRef.Ref)
+				refType, err := g.RefPathToGoType(responseOrRef.Ref)
 				if err != nil {
 					return nil, fmt.Errorf("error generating Go type for (%s) in parameter %s: %w", responseOrRef.Ref, responseName, err)
 				}
-				typeDef.TypeName = SchemaNameToTypeName(refType)
+				typeDef.TypeName = g.SchemaNameToTypeName(refType)
 			}
 
 			if jsonCount > 1 {
@@ -649,9 +924,14 @@ func GenerateTypesForResponses(t *template.Template, responses openapi3.Response
 	return types, nil
 }
 
+// GenerateTypesForRequestBodies calls Generator.GenerateTypesForRequestBodies using the default Configuration.
+func GenerateTypesForRequestBodies(t *template.Template, bodies map[string]*openapi3.RequestBodyRef) ([]TypeDefinition, error) {
+	return defaultGenerator.GenerateTypesForRequestBodies(t, bodies)
+}
+
 // GenerateTypesForRequestBodies generates type definitions for any custom types defined in the
 // components/requestBodies section of the Swagger spec.
-func GenerateTypesForRequestBodies(t *template.Template, bodies map[string]*openapi3.RequestBodyRef) ([]TypeDefinition, error) {
+func (g *Generator) GenerateTypesForRequestBodies(t *template.Template, bodies map[string]*openapi3.RequestBodyRef) ([]TypeDefinition, error) {
 	var types []TypeDefinition
 
 	for _, requestBodyName := range SortedMapKeys(bodies) {
@@ -665,29 +945,30 @@ func GenerateTypesForRequestBodies(t *template.Template, bodies map[string]*open
 				continue
 			}
 
-			goType, err := GenerateGoSchema(body.Schema, []string{requestBodyName})
+			goType, err := g.withVariant(requestVariant).GenerateGoSchema(body.Schema, []string{requestBodyName})
 			if err != nil {
 				return nil, fmt.Errorf("error generating Go type for schema in body %s: %w", requestBodyName, err)
 			}
 
-			goTypeName, err := renameRequestBody(requestBodyName, requestBodyRef)
+			goTypeName, err := g.renameRequestBody(requestBodyName, requestBodyRef)
 			if err != nil {
 				return nil, fmt.Errorf("error making name for components/schemas/%s: %w", requestBodyName, err)
 			}
 
 			typeDef := TypeDefinition{
-				JsonName: requestBodyName,
-				Schema:   goType,
-				TypeName: goTypeName,
+				JsonName:  requestBodyName,
+				Schema:    goType,
+				TypeName:  goTypeName,
+				generator: g,
 			}
 
 			if requestBodyRef.Ref != "" {
 				// Generate a reference type for referenced bodies
-				refType, err := RefPathToGoType(requestBodyRef.Ref)
+				refType, err := g.RefPathToGoType(requestBodyRef.Ref)
 				if err != nil {
 					return nil, fmt.Errorf("error generating Go type for (%s) in body %s: %w", requestBodyRef.Ref, requestBodyName, err)
 				}
-				typeDef.TypeName = SchemaNameToTypeName(refType)
+				typeDef.TypeName = g.SchemaNameToTypeName(refType)
 			}
 			types = append(types, typeDef)
 		}
@@ -728,7 +1009,14 @@ func GenerateTypes(t *template.Template, types []TypeDefinition) (string, error)
 	return GenerateTemplates([]string{"typedef.tmpl"}, t, context)
 }
 
+// GenerateEnums calls Generator.GenerateEnums using the default Configuration.
 func GenerateEnums(t *template.Template, types []TypeDefinition) (string, error) {
+	return defaultGenerator.GenerateEnums(t, types)
+}
+
+// GenerateEnums generates the constants for the values of any enums within the
+// given types, prefixing them with their type name where they would conflict.
+func (g *Generator) GenerateEnums(t *template.Template, types []TypeDefinition) (string, error) {
 	enums := []EnumDefinition{}
 
 	// Keep track of which enums we've generated
@@ -751,7 +1039,7 @@ func GenerateEnums(t *template.Template, types []TypeDefinition) (string, error)
 				Schema:         tp.Schema,
 				TypeName:       tp.TypeName,
 				ValueWrapper:   wrapper,
-				PrefixTypeName: globalState.options.Compatibility.AlwaysPrefixEnumValues,
+				PrefixTypeName: g.opts.Compatibility.AlwaysPrefixEnumValues,
 			})
 		}
 	}
@@ -801,11 +1089,22 @@ func GenerateEnums(t *template.Template, types []TypeDefinition) (string, error)
 
 	// Now see if enums conflict with any non-enum typenames
 
+	if g.opts.OutputOptions.EnumHelpers {
+		if err := checkEnumHelperConflicts(enums, types); err != nil {
+			return "", err
+		}
+	}
+
 	return GenerateTemplates([]string{"constants.tmpl"}, t, Constants{EnumDefinitions: enums})
 }
 
-// GenerateImports generates our import statements and package definition.
+// GenerateImports calls Generator.GenerateImports using the default Configuration.
 func GenerateImports(t *template.Template, externalImports []string, packageName string, versionOverride *string) (string, error) {
+	return defaultGenerator.GenerateImports(t, externalImports, packageName, versionOverride)
+}
+
+// GenerateImports generates our import statements and package definition.
+func (g *Generator) GenerateImports(t *template.Template, externalImports []string, packageName string, versionOverride *string) (string, error) {
 	// Read build version for incorporating into generated files
 	// Unit tests have ok=false, so we'll just use "unknown" for the
 	// version if we can't read this.
@@ -835,7 +1134,7 @@ func GenerateImports(t *template.Template, externalImports []string, packageName
 		PackageName:       packageName,
 		ModuleName:        modulePath,
 		Version:           moduleVersion,
-		AdditionalImports: globalState.options.AdditionalImports,
+		AdditionalImports: g.opts.AdditionalImports,
 	}
 
 	return GenerateTemplates([]string{"imports.tmpl"}, t, context)
@@ -872,7 +1171,7 @@ func GenerateAdditionalPropertyBoilerplate(t *template.Template, typeDefs []Type
 func GenerateUnionBoilerplate(t *template.Template, typeDefs []TypeDefinition) (string, error) {
 	var filteredTypes []TypeDefinition
 	for _, t := range typeDefs {
-		if len(t.Schema.UnionElements) != 0 {
+		if len(t.Schema.UnionElements) != 0 && !t.Schema.SealedUnion {
 			filteredTypes = append(filteredTypes, t)
 		}
 	}
@@ -1188,6 +1487,11 @@ func GetParametersImports(params map[string]*openapi3.ParameterRef) (map[string]
 	return res, nil
 }
 
+// SetGlobalStateSpec sets the spec used by the package level functions, such
+// as RefPathToGoType, to look up the components of the spec.
+//
+// Deprecated: this isn't safe to use concurrently with those functions. Instead,
+// create a Generator for the spec with NewGenerator, and use its methods.
 func SetGlobalStateSpec(spec *openapi3.T) {
-	globalState.spec = spec
+	defaultGenerator.spec = spec
 }
@@ -85,6 +85,74 @@ type GetTestByNameResponse struct {
 	assert.Contains(t, code, "Double EnumTestEnumVarnames = 2")
 }
 
+func TestGenerateFiles(t *testing.T) {
+	opts := Configuration{
+		PackageName: "testswagger",
+		Generate: GenerateOptions{
+			EchoServer:   true,
+			Client:       true,
+			Models:       true,
+			EmbeddedSpec: true,
+		},
+	}
+
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+
+	swagger, err := loader.LoadFromData([]byte(testOpenAPIDefinition))
+	require.NoError(t, err)
+
+	files, err := GenerateFiles(swagger, opts)
+	require.NoError(t, err)
+
+	require.Len(t, files, 4)
+	for _, name := range []string{TypesFileName, ClientFileName, ServerFileName, SpecFileName} {
+		code, ok := files[name]
+		require.True(t, ok, "expected %s to be generated", name)
+
+		// Check that we have valid (formattable) code:
+		_, err = format.Source([]byte(code))
+		assert.NoError(t, err)
+
+		assert.Contains(t, code, "package testswagger")
+	}
+
+	assert.Contains(t, files[TypesFileName], "type GetTestByNameParams struct {")
+	assert.Contains(t, files[TypesFileName], "type EnumTestNumerics int")
+	assert.NotContains(t, files[TypesFileName], "type ServerInterface interface {")
+
+	assert.Contains(t, files[ClientFileName], "type GetTestByNameResponse struct {")
+	assert.Contains(t, files[ClientFileName], "func (c *Client) GetTestByName(ctx context.Context, name string, params *GetTestByNameParams, reqEditors ...RequestEditorFn) (*http.Response, error) {")
+	assert.NotContains(t, files[ClientFileName], "type EnumTestNumerics int")
+
+	assert.Contains(t, files[ServerFileName], "type ServerInterface interface {")
+	assert.Contains(t, files[ServerFileName], `"github.com/labstack/echo/v4"`)
+	assert.NotContains(t, files[TypesFileName], `"github.com/labstack/echo/v4"`)
+
+	assert.Contains(t, files[SpecFileName], "func GetSwagger() (swagger *openapi3.T, err error) {")
+}
+
+func TestGenerateFilesOnlyModels(t *testing.T) {
+	opts := Configuration{
+		PackageName: "testswagger",
+		Generate: GenerateOptions{
+			Models: true,
+		},
+	}
+
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+
+	swagger, err := loader.LoadFromData([]byte(testOpenAPIDefinition))
+	require.NoError(t, err)
+
+	files, err := GenerateFiles(swagger, opts)
+	require.NoError(t, err)
+
+	require.Len(t, files, 1)
+	assert.Contains(t, files[TypesFileName], "type GetTestByNameParams struct {")
+}
+
 func TestExtPropGoTypeSkipOptionalPointer(t *testing.T) {
 	packageName := "api"
 	opts := Configuration{
@@ -229,3 +297,357 @@ func (t *ExampleSchema_Item) FromExternalRef0NewPet(v externalRef0.NewPet) error
 
 //go:embed test_spec.yaml
 var testOpenAPIDefinition string
+
+func TestGenerateServerTargets(t *testing.T) {
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+
+	swagger, err := loader.LoadFromData([]byte(testOpenAPIDefinition))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		generate GenerateOptions
+		contains []string
+	}{
+		{
+			name:     "fiber-v3",
+			generate: GenerateOptions{FiberV3Server: true},
+			contains: []string{
+				`"github.com/gofiber/fiber/v3"`,
+				"GetTestByName(c fiber.Ctx, name string, params GetTestByNameParams) error",
+				"func (siw *ServerInterfaceWrapper) GetTestByName(c fiber.Ctx) error {",
+				"func RegisterHandlersWithOptions(router fiber.Router, si ServerInterface, options FiberServerOptions) {",
+				`router.Get(options.BaseURL+"/test/:name", wrapper.GetTestByName)`,
+				"func (response GetTestByName200JSONResponse) VisitGetTestByNameResponse(ctx fiber.Ctx) error {",
+				"return sh.ssi.GetTestByName(ctx.Context(), request.(GetTestByNameRequestObject))",
+				"GetTestByNameFunc func(c fiber.Ctx, name string, params GetTestByNameParams) error",
+			},
+		},
+		{
+			name:     "hertz",
+			generate: GenerateOptions{HertzServer: true},
+			contains: []string{
+				`"github.com/cloudwego/hertz/pkg/app"`,
+				`"github.com/cloudwego/hertz/pkg/route"`,
+				"GetTestByName(ctx context.Context, c *app.RequestContext, name string, params GetTestByNameParams)",
+				"func (siw *ServerInterfaceWrapper) GetTestByName(ctx context.Context, c *app.RequestContext) {",
+				"func RegisterHandlersWithOptions(router route.IRouter, si ServerInterface, options HertzServerOptions) {",
+				`router.GET(options.BaseURL+"/test/:name", wrapper.GetTestByName)`,
+				"func (response GetTestByName200JSONResponse) VisitGetTestByNameResponse(w http.ResponseWriter) error {",
+				"validResponse.VisitGetTestByNameResponse(adaptor.GetCompatResponseWriter(&c.Response))",
+				"GetTestByNameFunc func(ctx context.Context, c *app.RequestContext, name string, params GetTestByNameParams)",
+			},
+		},
+		{
+			name:     "httprouter",
+			generate: GenerateOptions{HttprouterServer: true},
+			contains: []string{
+				`"github.com/julienschmidt/httprouter"`,
+				"GetTestByName(w http.ResponseWriter, r *http.Request, name string, params GetTestByNameParams)",
+				`err = runtime.BindStyledParameterWithOptions("simple", "name", httprouter.ParamsFromContext(r.Context()).ByName("name"), &name,`,
+				"func HandlerWithOptions(si ServerInterface, options HttprouterServerOptions) http.Handler {",
+				`r.Handler("GET", options.BaseURL+"/test/:name", http.HandlerFunc(wrapper.GetTestByName))`,
+				"func (response GetTestByName200JSONResponse) VisitGetTestByNameResponse(w http.ResponseWriter) error {",
+				"GetTestByNameFunc func(w http.ResponseWriter, r *http.Request, name string, params GetTestByNameParams)",
+			},
+		},
+		{
+			name:     "fasthttp",
+			generate: GenerateOptions{FasthttpServer: true},
+			contains: []string{
+				`"github.com/valyala/fasthttp"`,
+				`fasthttprouter "github.com/fasthttp/router"`,
+				"GetTestByName(ctx *fasthttp.RequestCtx, name string, params GetTestByNameParams)",
+				`err = runtime.BindStyledParameterWithOptions("simple", "name", fasthttpPathParam(ctx, "name"), &name,`,
+				`err = runtime.BindQueryParameter("form", true, false, "$top", fasthttpQueryValues(query), &params.Top)`,
+				"func HandlerWithOptions(si ServerInterface, options FasthttpServerOptions) fasthttp.RequestHandler {",
+				`r.Handle("GET", options.BaseURL+"/test/{name}", wrapper.GetTestByName)`,
+				"func (response GetTestByName200JSONResponse) VisitGetTestByNameResponse(ctx *fasthttp.RequestCtx) error {",
+				"return sh.ssi.GetTestByName(ctx, request.(GetTestByNameRequestObject))",
+				"GetTestByNameFunc func(ctx *fasthttp.RequestCtx, name string, params GetTestByNameParams)",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := Configuration{
+				PackageName: "testswagger",
+				Generate:    tt.generate,
+			}
+			opts.Generate.Strict = true
+			opts.Generate.Models = true
+			opts.Generate.Mocks = true
+			require.NoError(t, opts.Validate())
+
+			code, err := Generate(swagger, opts)
+			require.NoError(t, err)
+
+			_, err = format.Source([]byte(code))
+			require.NoError(t, err)
+
+			for _, s := range tt.contains {
+				assert.Contains(t, code, s)
+			}
+			assert.NotContains(t, code, `"github.com/gofiber/fiber/v2"`)
+		})
+	}
+
+	opts := Configuration{
+		PackageName: "testswagger",
+		Generate:    GenerateOptions{FiberServer: true, FiberV3Server: true},
+	}
+	assert.EqualError(t, opts.Validate(), "only one server type is supported at a time")
+}
+
+func TestGenerateHttprouterServerRouteConflicts(t *testing.T) {
+	const spec = `
+openapi: "3.0.0"
+info:
+  version: 1.0.0
+  title: Route conflicts
+paths:
+  /pets/{id}:
+    get:
+      operationId: getPet
+      parameters:
+        - name: id
+          in: path
+          required: true
+          schema:
+            type: string
+      responses:
+        '200':
+          description: A pet
+  /pets/search:
+    get:
+      operationId: searchPets
+      responses:
+        '200':
+          description: The pets found
+`
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+	require.NoError(t, err)
+
+	_, err = Generate(swagger, Configuration{
+		PackageName: "api",
+		Generate:    GenerateOptions{HttprouterServer: true},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "the path /pets/{id} of the operation GetPet conflicts with the path /pets/search of the operation SearchPets")
+
+	// The other routers allow them
+	_, err = Generate(swagger, Configuration{
+		PackageName: "api",
+		Generate:    GenerateOptions{ChiServer: true},
+	})
+	require.NoError(t, err)
+}
+
+func TestCheckHttprouterRoutes(t *testing.T) {
+	tests := []struct {
+		name     string
+		paths    [][2]string
+		conflict bool
+	}{
+		{"static and parameter", [][2]string{{"GET", "/pets/{id}"}, {"GET", "/pets/search"}}, true},
+		{"parameters of different names", [][2]string{{"GET", "/pets/{id}"}, {"GET", "/pets/{petId}/toys"}}, true},
+		{"parameters of the same name", [][2]string{{"GET", "/pets/{id}"}, {"GET", "/pets/{id}/toys"}}, false},
+		{"different methods", [][2]string{{"GET", "/pets/{id}"}, {"POST", "/pets/search"}}, false},
+		{"different preceding segments", [][2]string{{"GET", "/pets/{id}"}, {"GET", "/owners/search"}}, false},
+		{"shorter path", [][2]string{{"GET", "/pets/{id}"}, {"GET", "/pets"}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ops []OperationDefinition
+			for _, p := range tt.paths {
+				ops = append(ops, OperationDefinition{Method: p[0], Path: p[1]})
+			}
+			err := checkHttprouterRoutes(ops)
+			if tt.conflict {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGenerateValidationMiddleware(t *testing.T) {
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+
+	swagger, err := loader.LoadFromData([]byte(testOpenAPIDefinition))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		generate   GenerateOptions
+		middleware string
+	}{
+		{"chi", GenerateOptions{ChiServer: true}, "func RequestValidatorMiddleware(options RequestValidatorOptions) (func(http.Handler) http.Handler, error) {"},
+		{"echo", GenerateOptions{EchoServer: true}, "func RequestValidatorMiddleware(options RequestValidatorOptions) (echo.MiddlewareFunc, error) {"},
+		{"fiber", GenerateOptions{FiberServer: true}, "func RequestValidatorMiddleware(options RequestValidatorOptions) (fiber.Handler, error) {"},
+		{"fiber-v3", GenerateOptions{FiberV3Server: true}, "func RequestValidatorMiddleware(options RequestValidatorOptions) (fiber.Handler, error) {"},
+		{"gin", GenerateOptions{GinServer: true}, "func RequestValidatorMiddleware(options RequestValidatorOptions) (gin.HandlerFunc, error) {"},
+		{"hertz", GenerateOptions{HertzServer: true}, "func RequestValidatorMiddleware(options RequestValidatorOptions) (app.HandlerFunc, error) {"},
+		{"httprouter", GenerateOptions{HttprouterServer: true}, "func RequestValidatorMiddleware(options RequestValidatorOptions) (func(http.Handler) http.Handler, error) {"},
+		{"fasthttp", GenerateOptions{FasthttpServer: true}, "func RequestValidatorMiddleware(options RequestValidatorOptions) (func(fasthttp.RequestHandler) fasthttp.RequestHandler, error) {"},
+		{"iris", GenerateOptions{IrisServer: true}, "func RequestValidatorMiddleware(options RequestValidatorOptions) (iris.Handler, error) {"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := Configuration{
+				PackageName: "testswagger",
+				Generate:    tt.generate,
+			}
+			opts.Generate.Models = true
+			opts.Generate.EmbeddedSpec = true
+			opts.Generate.ValidationMiddleware = true
+			require.NoError(t, opts.Validate())
+
+			code, err := Generate(swagger, opts)
+			require.NoError(t, err)
+
+			_, err = format.Source([]byte(code))
+			require.NoError(t, err)
+
+			assert.Contains(t, code, tt.middleware)
+			assert.Contains(t, code, "type RequestValidationErrorFormatter func(r *http.Request, err *RequestValidationError) (statusCode int, body interface{})")
+			assert.Contains(t, code, `"github.com/getkin/kin-openapi/openapi3filter"`)
+			assert.Contains(t, code, `"github.com/getkin/kin-openapi/routers/gorillamux"`)
+		})
+	}
+}
+
+func TestValidateValidationMiddleware(t *testing.T) {
+	opts := Configuration{
+		PackageName: "testswagger",
+		Generate: GenerateOptions{
+			ChiServer:            true,
+			ValidationMiddleware: true,
+		},
+	}
+	err := opts.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "requires `embedded-spec`")
+
+	opts.Generate = GenerateOptions{
+		Models:               true,
+		EmbeddedSpec:         true,
+		ValidationMiddleware: true,
+	}
+	err = opts.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "requires a server to be generated")
+}
+
+func TestGenerateStrictResponseValidation(t *testing.T) {
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+
+	swagger, err := loader.LoadFromData([]byte(testOpenAPIDefinition))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		visit string
+	}{
+		{"chi", "func (response validatedGetTestByNameResponse) VisitGetTestByNameResponse(w http.ResponseWriter) error {"},
+		{"echo", "func (response validatedGetTestByNameResponse) VisitGetTestByNameResponse(w http.ResponseWriter) error {"},
+		{"fiber", "func (response validatedGetTestByNameResponse) VisitGetTestByNameResponse(ctx *fiber.Ctx) error {"},
+		{"fiber-v3", "func (response validatedGetTestByNameResponse) VisitGetTestByNameResponse(ctx fiber.Ctx) error {"},
+		{"gin", "func (response validatedGetTestByNameResponse) VisitGetTestByNameResponse(w http.ResponseWriter) error {"},
+		{"hertz", "func (response validatedGetTestByNameResponse) VisitGetTestByNameResponse(w http.ResponseWriter) error {"},
+		{"httprouter", "func (response validatedGetTestByNameResponse) VisitGetTestByNameResponse(w http.ResponseWriter) error {"},
+		{"fasthttp", "func (response validatedGetTestByNameResponse) VisitGetTestByNameResponse(ctx *fasthttp.RequestCtx) error {"},
+		{"iris", "func (response validatedGetTestByNameResponse) VisitGetTestByNameResponse(ctx iris.Context) error {"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := Configuration{
+				PackageName: "testswagger",
+				Generate: GenerateOptions{
+					ChiServer:                tt.name == "chi",
+					EchoServer:               tt.name == "echo",
+					FiberServer:              tt.name == "fiber",
+					FiberV3Server:            tt.name == "fiber-v3",
+					GinServer:                tt.name == "gin",
+					HertzServer:              tt.name == "hertz",
+					HttprouterServer:         tt.name == "httprouter",
+					FasthttpServer:           tt.name == "fasthttp",
+					IrisServer:               tt.name == "iris",
+					Strict:                   true,
+					Models:                   true,
+					EmbeddedSpec:             true,
+					StrictResponseValidation: true,
+				},
+			}
+			require.NoError(t, opts.Validate())
+
+			code, err := Generate(swagger, opts)
+			require.NoError(t, err)
+
+			_, err = format.Source([]byte(code))
+			require.NoError(t, err)
+
+			assert.Contains(t, code, "func NewStrictResponseValidator(options ResponseValidatorOptions) (StrictMiddlewareFunc, error) {")
+			assert.Contains(t, code, `"GetTestByName": {"GET", "/test/{name}"},`)
+			assert.Contains(t, code, tt.visit)
+		})
+	}
+}
+
+func TestValidateStrictResponseValidation(t *testing.T) {
+	opts := Configuration{
+		PackageName: "testswagger",
+		Generate: GenerateOptions{
+			ChiServer:                true,
+			EmbeddedSpec:             true,
+			StrictResponseValidation: true,
+		},
+	}
+	err := opts.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "requires `strict-server`")
+
+	opts.Generate.Strict = true
+	opts.Generate.EmbeddedSpec = false
+	err = opts.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "requires `embedded-spec`")
+}
+
+func TestGenerateClientRetries(t *testing.T) {
+	swagger := &openapi3.T{
+		OpenAPI: "3.0.0",
+		Paths: openapi3.NewPaths(openapi3.WithPath("/search", &openapi3.PathItem{
+			Post: &openapi3.Operation{
+				OperationID: "search",
+				Extensions:  map[string]interface{}{extIdempotent: true},
+				Responses:   openapi3.NewResponses(),
+			},
+		})),
+	}
+	opts := Configuration{
+		PackageName: "api",
+		Generate:    GenerateOptions{Client: true},
+	}
+
+	// The retries are opt-in
+	code, err := Generate(swagger, opts)
+	require.NoError(t, err)
+	assert.NotContains(t, code, "RetryPolicy")
+	assert.NotContains(t, code, "withIdempotency")
+	assert.NotContains(t, code, `"math/rand"`)
+
+	opts.OutputOptions.ClientRetries = true
+	code, err = Generate(swagger, opts)
+	require.NoError(t, err)
+	_, err = format.Source([]byte(code))
+	require.NoError(t, err)
+
+	assert.Contains(t, code, "func WithRetryPolicy(policy RetryPolicy) ClientOption {")
+	assert.Contains(t, code, "client.Client = &retryingDoer{doer: client.Client, policy: client.retryPolicy.withDefaults()}")
+	assert.Contains(t, code, "req = req.WithContext(withIdempotency(ctx, true))")
+}
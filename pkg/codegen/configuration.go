@@ -25,6 +25,8 @@ type Configuration struct {
 	ImportMapping map[string]string `yaml:"import-mapping,omitempty"`
 	// AdditionalImports defines any additional Go imports to add to the generated code
 	AdditionalImports []AdditionalImport `yaml:"additional-imports,omitempty"`
+	// TypeMapping maps OpenAPI types and formats to the Go types which represent them
+	TypeMapping TypeMapping `yaml:"type-mapping,omitempty"`
 	// NoVCSVersionOverride allows overriding the version of the application for cases where no Version Control System (VCS) is available when building, for instance when using a Nix derivation.
 	// See documentation for how to use it in examples/no-vcs-version-override/README.md
 	NoVCSVersionOverride *string `yaml:"-"`
@@ -47,6 +49,9 @@ func (o Configuration) Validate() error {
 	if o.Generate.FiberServer {
 		nServers++
 	}
+	if o.Generate.FiberV3Server {
+		nServers++
+	}
 	if o.Generate.EchoServer {
 		nServers++
 	}
@@ -59,6 +64,15 @@ func (o Configuration) Validate() error {
 	if o.Generate.GinServer {
 		nServers++
 	}
+	if o.Generate.HttprouterServer {
+		nServers++
+	}
+	if o.Generate.FasthttpServer {
+		nServers++
+	}
+	if o.Generate.HertzServer {
+		nServers++
+	}
 	if nServers > 1 {
 		return errors.New("only one server type is supported at a time")
 	}
@@ -82,6 +96,20 @@ func (o Configuration) Validate() error {
 		}
 	}
 
+	if o.OutputOptions.TagPackages != nil && !o.Generate.Models {
+		errs = append(errs, errors.New("`output-options` configuration for tag-packages was incorrect: requires `generate.models`, as the tag packages refer to the types of the shared models package"))
+	}
+
+	if o.OutputOptions.StrictEnumUnmarshal && !o.OutputOptions.EnumHelpers {
+		errs = append(errs, errors.New("`output-options` configuration for strict-enum-unmarshal was incorrect: requires `output-options.enum-helpers`, as the methods parse the values with the enum helpers"))
+	}
+
+	if problems := o.TypeMapping.Validate(); problems != nil {
+		for _, k := range SortedMapKeys(problems) {
+			errs = append(errs, fmt.Errorf("`type-mapping` configuration for %v was incorrect: %v", k, problems[k]))
+		}
+	}
+
 	err := errors.Join(errs...)
 	if err != nil {
 		return fmt.Errorf("failed to validate configuration: %w", err)
@@ -110,14 +138,22 @@ type GenerateOptions struct {
 	ChiServer bool `yaml:"chi-server,omitempty"`
 	// FiberServer specifies whether to generate fiber server boilerplate
 	FiberServer bool `yaml:"fiber-server,omitempty"`
+	// FiberV3Server specifies whether to generate fiber v3 server boilerplate
+	FiberV3Server bool `yaml:"fiber-v3-server,omitempty"`
 	// EchoServer specifies whether to generate echo server boilerplate
 	EchoServer bool `yaml:"echo-server,omitempty"`
 	// GinServer specifies whether to generate gin server boilerplate
 	GinServer bool `yaml:"gin-server,omitempty"`
+	// HertzServer specifies whether to generate hertz server boilerplate
+	HertzServer bool `yaml:"hertz-server,omitempty"`
 	// GorillaServer specifies whether to generate Gorilla server boilerplate
 	GorillaServer bool `yaml:"gorilla-server,omitempty"`
 	// StdHTTPServer specifies whether to generate stdlib http server boilerplate
 	StdHTTPServer bool `yaml:"std-http-server,omitempty"`
+	// HttprouterServer specifies whether to generate julienschmidt/httprouter server boilerplate
+	HttprouterServer bool `yaml:"httprouter-server,omitempty"`
+	// FasthttpServer specifies whether to generate fasthttp server boilerplate
+	FasthttpServer bool `yaml:"fasthttp-server,omitempty"`
 	// Strict specifies whether to generate strict server wrapper
 	Strict bool `yaml:"strict-server,omitempty"`
 	// Client specifies whether to generate client boilerplate
@@ -126,10 +162,67 @@ type GenerateOptions struct {
 	Models bool `yaml:"models,omitempty"`
 	// EmbeddedSpec indicates whether to embed the swagger spec in the generated code
 	EmbeddedSpec bool `yaml:"embedded-spec,omitempty"`
+	// WebhookClient specifies whether to generate a client for sending the
+	// requests of the spec's webhooks and callbacks
+	WebhookClient bool `yaml:"webhook-client,omitempty"`
+	// WebhookServer specifies whether to generate a net/http server for
+	// receiving the requests of the spec's webhooks and callbacks, along with
+	// a strict server if Strict is also set
+	WebhookServer bool `yaml:"webhook-server,omitempty"`
+	// ValidationMiddleware specifies whether to generate a middleware for the
+	// generated server which validates requests against the embedded spec
+	ValidationMiddleware bool `yaml:"validation-middleware,omitempty"`
+	// StrictResponseValidation specifies whether to generate a strict
+	// middleware which validates the responses of the strict server against
+	// the embedded spec
+	StrictResponseValidation bool `yaml:"strict-response-validation,omitempty"`
+	// Mocks specifies whether to generate fakes of the interfaces of the
+	// generated client, server and strict server, for use in tests
+	Mocks bool `yaml:"mocks,omitempty"`
+	// StubServer specifies whether to generate an implementation of the
+	// strict server which responds with the examples of the spec
+	StubServer bool `yaml:"stub-server,omitempty"`
+	// SpecHandler specifies whether to generate a handler serving the
+	// embedded spec as JSON and YAML, along with a page documenting it
+	SpecHandler bool `yaml:"spec-handler,omitempty"`
+	// SecurityHandler specifies whether to generate a SecurityHandler, which
+	// the generated server requires to authenticate the requests of the
+	// operations with security requirements before handling them
+	SecurityHandler bool `yaml:"security-handler,omitempty"`
 }
 
 func (oo GenerateOptions) Validate() map[string]string {
-	return nil
+	problems := map[string]string{}
+	if oo.ValidationMiddleware {
+		if !oo.EmbeddedSpec {
+			problems["validation-middleware"] = "requires `embedded-spec`, as requests are validated against the embedded spec"
+		} else if !(oo.ChiServer || oo.EchoServer || oo.FasthttpServer || oo.FiberServer || oo.FiberV3Server || oo.GinServer || oo.GorillaServer || oo.HertzServer || oo.HttprouterServer || oo.IrisServer || oo.StdHTTPServer) {
+			problems["validation-middleware"] = "requires a server to be generated"
+		}
+	}
+	if oo.StrictResponseValidation {
+		if !oo.Strict {
+			problems["strict-response-validation"] = "requires `strict-server`"
+		} else if !oo.EmbeddedSpec {
+			problems["strict-response-validation"] = "requires `embedded-spec`, as responses are validated against the embedded spec"
+		}
+	}
+	if oo.StubServer && !oo.Strict {
+		problems["stub-server"] = "requires `strict-server`"
+	}
+	if oo.SpecHandler && !oo.EmbeddedSpec {
+		problems["spec-handler"] = "requires `embedded-spec`, as the embedded spec is what's served"
+	}
+	if oo.SecurityHandler && !(oo.ChiServer || oo.EchoServer || oo.FasthttpServer || oo.FiberServer || oo.FiberV3Server || oo.GinServer || oo.GorillaServer || oo.HertzServer || oo.HttprouterServer || oo.IrisServer || oo.StdHTTPServer) {
+		problems["security-handler"] = "requires a server to be generated"
+	}
+	if oo.Mocks && !(oo.Client || oo.Strict || oo.ChiServer || oo.EchoServer || oo.FasthttpServer || oo.FiberServer || oo.FiberV3Server || oo.GinServer || oo.GorillaServer || oo.HertzServer || oo.HttprouterServer || oo.IrisServer || oo.StdHTTPServer) {
+		problems["mocks"] = "requires a client or a server to be generated"
+	}
+	if len(problems) == 0 {
+		return nil
+	}
+	return problems
 }
 
 // CompatibilityOptions specifies backward compatibility settings for the
@@ -228,6 +321,24 @@ type OutputOptions struct {
 	InitialismOverrides bool `yaml:"initialism-overrides,omitempty"`
 	// Whether to generate nullable type for nullable fields
 	NullableType bool `yaml:"nullable-type,omitempty"`
+	// FieldRepresentation is how the optional and nullable fields of the types are represented, either with pointers, or with `nullable.Nullable[T]`, which tells apart the fields which are unset from those which are null. Can be overridden for an object's properties, or a single property, with the `x-go-field-representation` extension. Corresponds with the constants defined for `codegen.FieldRepresentation`, and defaults to `pointer`
+	FieldRepresentation string `yaml:"field-representation,omitempty"`
+	// Whether to generate a `Validate() error` method for each type, which checks the constraints of its schema, such as `minLength`, `maximum`, `pattern` or `required`
+	GenerateValidators bool `yaml:"generate-validators,omitempty"`
+	// Whether to generate an `ApplyDefaults()` method and a `New<Type>()` constructor for each type with properties with a `default`, which are applied when unmarshaling, and to the missing parameters of server requests
+	GenerateDefaults bool `yaml:"generate-defaults,omitempty"`
+	// Whether to generate `<Type>Request` and `<Type>Response` variants of the types with `readOnly` or `writeOnly` properties, which omit the properties which aren't sent in requests or responses respectively, and are used for the request bodies and responses of the operations
+	GenerateReadWriteVariants bool `yaml:"generate-read-write-variants,omitempty"`
+	// Whether to represent the `oneOf` unions with a `discriminator` with a sealed interface, implemented by each of the types of the union, rather than with `json.RawMessage` and accessors. Applies to the unions defined in `components`, including those nested within their schemas, which have no properties of their own, and whose types all refer to component schemas. The unions written inline in the request bodies and responses of operations keep the `json.RawMessage` representation
+	SealedUnions bool `yaml:"sealed-unions,omitempty"`
+	// Whether to generate helpers for string and integer enums, namely `Valid()`, `String()`, `<Type>Values()` and `Parse<Type>()`
+	EnumHelpers bool `yaml:"enum-helpers,omitempty"`
+	// Whether to generate `UnmarshalJSON` and `UnmarshalText` methods for string and integer enums, which reject any value which isn't one of the enum's
+	StrictEnumUnmarshal bool `yaml:"strict-enum-unmarshal,omitempty"`
+	// Whether to generate a `WithRetryPolicy` client option, which retries the requests which fail according to a `RetryPolicy`, with exponential backoff. Only the requests to idempotent operations are retried by default, which can be overridden with the `x-idempotent` extension
+	ClientRetries bool `yaml:"client-retries,omitempty"`
+	// Whether to generate callback-based pagination helpers for operations with the `x-pagination` extension, for Go versions older than 1.23, instead of `iter.Seq2` iterators
+	PaginationCallbacks bool `yaml:"pagination-callbacks,omitempty"`
 
 	// DisableTypeAliasesForType allows defining which OpenAPI `type`s will explicitly not use type aliases
 	// Currently supports:
@@ -239,9 +350,22 @@ type OutputOptions struct {
 
 	// Overlay defines configuration for the OpenAPI Overlay (https://github.com/OAI/Overlay-Specification) to manipulate the OpenAPI specification before generation. This allows modifying the specification without needing to apply changes directly to it, making it easier to keep it up-to-date.
 	Overlay OutputOptionsOverlay `yaml:"overlay"`
+
+	// TagPackages configures generating one Go package per OpenAPI tag, alongside a shared package for the models, rather than a single package. Only used by `GenerateTagPackages`.
+	TagPackages *TagPackagesOptions `yaml:"tag-packages,omitempty"`
 }
 
 func (oo OutputOptions) Validate() map[string]string {
+	if oo.TagPackages != nil && oo.TagPackages.BaseImportPath == "" {
+		return map[string]string{
+			"tag-packages": "base-import-path must be specified",
+		}
+	}
+	if !FieldRepresentation(oo.FieldRepresentation).Valid() {
+		return map[string]string{
+			"field-representation": fmt.Sprintf("must be one of %q", FieldRepresentations),
+		}
+	}
 	return nil
 }
 
@@ -252,3 +376,13 @@ type OutputOptionsOverlay struct {
 	// Defaults to true.
 	Strict *bool `yaml:"strict,omitempty"`
 }
+
+// TagPackagesOptions configures generating one Go package per OpenAPI tag.
+type TagPackagesOptions struct {
+	// BaseImportPath is the Go import path of the directory that each of the packages are generated into, which is used to import the shared models package.
+	BaseImportPath string `yaml:"base-import-path"`
+
+	// ModelsPackage is the name of the shared package the types for the spec's components are generated into.
+	// Defaults to `models`.
+	ModelsPackage string `yaml:"models-package,omitempty"`
+}
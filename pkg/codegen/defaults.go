@@ -0,0 +1,434 @@
+package codegen
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+// maxDefaultsDepth bounds how deeply the defaults of values whose types don't
+// have an ApplyDefaults method are inlined.
+const maxDefaultsDepth = 8
+
+// DefaultsDefinition describes the ApplyDefaults method generated for a type,
+// along with its constructor.
+type DefaultsDefinition struct {
+	// TypeName is the name of the type the method is generated for
+	TypeName string
+	// Body contains the statements which set the unset properties of the
+	// value of the type, `t`, to their defaults.
+	Body string
+	// Constructor is whether the New<TypeName> constructor is generated,
+	// which it isn't when its name is already taken
+	Constructor bool
+	// UnmarshalJSON is whether an UnmarshalJSON method applying the defaults
+	// is generated, which it isn't for the types which have their own
+	UnmarshalJSON bool
+}
+
+// GenerateDefaults generates an ApplyDefaults method, and a constructor, for
+// each of the given types which has properties with defaults, directly or
+// within the objects, arrays and maps nested within it. The parameters of the
+// operations get an ApplyDefaults method for their optional parameters with
+// defaults, which the server wrappers call.
+func (g *Generator) GenerateDefaults(t *template.Template, types []TypeDefinition, ops []OperationDefinition) (string, error) {
+	b := &defaultsBuilder{
+		g:       g,
+		local:   map[string]TypeDefinition{},
+		applied: map[string]bool{},
+	}
+
+	var candidates []TypeDefinition
+	for _, td := range types {
+		if _, seen := b.local[td.TypeName]; seen {
+			continue
+		}
+		b.local[td.TypeName] = td
+		if canHaveDefaults(td) {
+			candidates = append(candidates, td)
+		}
+	}
+
+	// The parameters of operations with defaults always get the method, as
+	// the server wrappers call it.
+	params := map[string]bool{}
+	reserved := map[string]bool{"Client": true, "ClientWithResponses": true, "StrictHandler": true, "StrictHandlerWithOptions": true}
+	for _, op := range ops {
+		params[op.OperationId+"Params"] = true
+		if op.HasParamDefaults() {
+			b.applied[op.OperationId+"Params"] = true
+		}
+		reserved[op.OperationId+"Request"] = true
+		reserved[op.OperationId+"RequestWithBody"] = true
+	}
+
+	// Whether a type has defaults depends on whether the types nested within
+	// it do, so the types with defaults are found by repeatedly generating
+	// the methods until no more are found.
+	bodies := map[string]string{}
+	for changed := true; changed; {
+		changed = false
+		for _, td := range candidates {
+			body, err := b.typeBody(td)
+			if err != nil {
+				return "", fmt.Errorf("error generating defaults for %s: %w", td.TypeName, err)
+			}
+			bodies[td.TypeName] = body
+			if body != "" && !b.applied[td.TypeName] {
+				b.applied[td.TypeName] = true
+				changed = true
+			}
+		}
+	}
+
+	var defaults []DefaultsDefinition
+	for _, td := range candidates {
+		if !b.applied[td.TypeName] {
+			continue
+		}
+		_, taken := b.local["New"+td.TypeName]
+		defaults = append(defaults, DefaultsDefinition{
+			TypeName:    td.TypeName,
+			Body:        bodies[td.TypeName],
+			Constructor: !taken && !reserved[td.TypeName],
+			UnmarshalJSON: strings.HasPrefix(td.Schema.TypeDecl(), "struct {") &&
+				!td.Schema.HasAdditionalProperties && len(td.Schema.UnionElements) == 0 && !params[td.TypeName],
+		})
+	}
+	if len(defaults) == 0 {
+		return "", nil
+	}
+
+	return GenerateTemplates([]string{"defaults.tmpl"}, t, defaults)
+}
+
+// canHaveDefaults returns whether an ApplyDefaults method can be declared on
+// the type, which is a struct, slice or map.
+func canHaveDefaults(td TypeDefinition) bool {
+	if td.IsAlias() {
+		return false
+	}
+	decl := td.Schema.TypeDecl()
+	return strings.HasPrefix(decl, "struct {") || strings.HasPrefix(decl, "[]") || strings.HasPrefix(decl, "map[")
+}
+
+// defaultsBuilder builds the bodies of the ApplyDefaults methods.
+type defaultsBuilder struct {
+	g *Generator
+	// local contains the types defined in the package, by name
+	local map[string]TypeDefinition
+	// applied contains the names of the types which have an ApplyDefaults
+	// method
+	applied map[string]bool
+	// vars counts the variables declared in the current method, to keep
+	// their names unique
+	vars int
+}
+
+func (b *defaultsBuilder) typeBody(td TypeDefinition) (string, error) {
+	b.vars = 0
+
+	var w strings.Builder
+	expr := "t"
+	if !strings.HasPrefix(td.Schema.TypeDecl(), "struct {") {
+		expr = "(*t)"
+	}
+	if err := b.value(&w, expr, td.Schema, 0); err != nil {
+		return "", err
+	}
+	return w.String(), nil
+}
+
+// value writes the statements applying the defaults within the addressable Go
+// expression expr, whose type is described by s.
+func (b *defaultsBuilder) value(w *strings.Builder, expr string, s Schema, depth int) error {
+	if depth > maxDefaultsDepth {
+		return nil
+	}
+	decl := s.TypeDecl()
+
+	switch {
+	case isNamedGoType(decl):
+		if b.applied[decl] {
+			fmt.Fprintf(w, "%s.ApplyDefaults()\n", deref(expr))
+			return nil
+		}
+		// The defaults within the types aliases refer to are applied here
+		if td, ok := b.local[decl]; ok && td.IsAlias() {
+			return b.value(w, expr, td.Schema, depth+1)
+		}
+
+	case strings.HasPrefix(decl, "struct {"):
+		if err := b.properties(w, expr, s.Properties, depth); err != nil {
+			return err
+		}
+		if s.HasAdditionalProperties && s.AdditionalPropertiesType != nil {
+			return b.mapValues(w, expr+".AdditionalProperties", *s.AdditionalPropertiesType, depth)
+		}
+
+	case s.ArrayType != nil:
+		vars := b.vars
+		i := b.newVar("i")
+		var items strings.Builder
+		if err := b.value(&items, expr+"["+i+"]", *s.ArrayType, depth+1); err != nil {
+			return err
+		}
+		if items.Len() == 0 {
+			b.vars = vars
+			return nil
+		}
+		fmt.Fprintf(w, "for %s := range %s {\n%s}\n", i, expr, items.String())
+
+	case strings.HasPrefix(decl, "map[string]") && s.AdditionalPropertiesType != nil:
+		return b.mapValues(w, expr, *s.AdditionalPropertiesType, depth)
+	}
+	return nil
+}
+
+// properties writes the statements applying the defaults of the fields of the
+// struct expr, and within them.
+func (b *defaultsBuilder) properties(w *strings.Builder, expr string, props []Property, depth int) error {
+	for _, p := range props {
+		// Ignored fields aren't part of the JSON representation
+		if extension, ok := p.Extensions[extPropGoJsonIgnore]; ok {
+			if ignore, err := extParseGoJsonIgnore(extension); err == nil && ignore {
+				continue
+			}
+		}
+		// Determine the type of the field as GenFieldsFromProperties does
+		if extension, ok := p.Extensions[extPropGoTypeSkipOptionalPointer]; ok {
+			if skipOptionalPointer, err := extParsePropGoTypeSkipOptionalPointer(extension); err == nil {
+				p.Schema.SkipOptionalPointer = skipOptionalPointer
+			}
+		}
+
+		field := deref(expr) + "." + p.GoFieldName()
+		typeDef := p.GoTypeDef()
+		decl := p.Schema.TypeDecl()
+
+		// Defaults don't apply to required properties, which are always
+		// present.
+		var def interface{}
+		if !p.Required && p.Schema.OAPISchema != nil {
+			def = p.Schema.OAPISchema.Default
+		}
+
+		switch {
+		case strings.HasPrefix(typeDef, "nullable.Nullable["):
+			if def != nil {
+				fmt.Fprintf(w, "if !%s.IsSpecified() {\n", field)
+				if err := b.assign(w, decl, p.Schema, def, func(v string) string {
+					return fmt.Sprintf("%s.Set(%s)\n", field, v)
+				}); err != nil {
+					return err
+				}
+				fmt.Fprintf(w, "}\n")
+			}
+			vars := b.vars
+			v := b.newVar("v")
+			var nested strings.Builder
+			if err := b.value(&nested, v, p.Schema, depth+1); err != nil {
+				return err
+			}
+			if nested.Len() == 0 {
+				b.vars = vars
+			} else {
+				fmt.Fprintf(w, "if %s, err := %s.Get(); err == nil {\n%s%s.Set(%s)\n}\n", v, field, nested.String(), field, v)
+			}
+
+		case strings.HasPrefix(typeDef, "*"):
+			if def != nil {
+				fmt.Fprintf(w, "if %s == nil {\n", field)
+				if err := b.assign(w, decl, p.Schema, def, func(v string) string {
+					return fmt.Sprintf("%s = &%s\n", field, v)
+				}); err != nil {
+					return err
+				}
+				fmt.Fprintf(w, "}\n")
+			}
+			var nested strings.Builder
+			if err := b.value(&nested, "(*"+field+")", p.Schema, depth+1); err != nil {
+				return err
+			}
+			if nested.Len() > 0 {
+				fmt.Fprintf(w, "if %s != nil {\n%s}\n", field, nested.String())
+			}
+
+		default:
+			// Fields which aren't pointers can't be told apart from their
+			// zero value when they're unset.
+			if def != nil {
+				if zero := b.zeroValue(decl); zero != "" {
+					lit, err := b.literal(decl, p.Schema, def)
+					if err != nil {
+						return err
+					}
+					if lit != "" {
+						fmt.Fprintf(w, "if %s == %s {\n%s = %s\n}\n", field, zero, field, lit)
+					}
+				}
+			}
+			if err := b.value(w, field, p.Schema, depth+1); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// mapValues writes the statements applying the defaults within the values of
+// the map expr, which aren't addressable, so are written back.
+func (b *defaultsBuilder) mapValues(w *strings.Builder, expr string, s Schema, depth int) error {
+	// Nullable values are stored as pointers
+	if s.OAPISchema != nil && s.OAPISchema.Nullable {
+		return nil
+	}
+	vars := b.vars
+	k, v := b.newVar("k"), b.newVar("v")
+	var nested strings.Builder
+	if err := b.value(&nested, v, s, depth+1); err != nil {
+		return err
+	}
+	if nested.Len() == 0 {
+		b.vars = vars
+		return nil
+	}
+	fmt.Fprintf(w, "for %s, %s := range %s {\n%s%s[%s] = %s\n}\n", k, v, expr, nested.String(), expr, k, v)
+	return nil
+}
+
+// assign writes the statements declaring a variable holding the default def
+// of the type decl, and assigning it with the statement returned by set. The
+// defaults which can't be written as a Go literal are unmarshaled from JSON.
+func (b *defaultsBuilder) assign(w *strings.Builder, decl string, s Schema, def interface{}, set func(v string) string) error {
+	v := b.newVar("v")
+	lit, err := b.literal(decl, s, def)
+	if err != nil {
+		return err
+	}
+	if lit != "" {
+		fmt.Fprintf(w, "%s := %s\n%s", v, lit, set(v))
+		return nil
+	}
+
+	data, err := json.Marshal(def)
+	if err != nil {
+		return fmt.Errorf("error marshaling default: %w", err)
+	}
+	fmt.Fprintf(w, "var %s %s\n", v, decl)
+	fmt.Fprintf(w, "if err := json.Unmarshal([]byte(%s), &%s); err == nil {\n%s}\n", quoteRaw(string(data)), v, set(v))
+	return nil
+}
+
+// literal returns the Go literal of the default def of the type decl, or ""
+// if it can't be written as one, in which case it's unmarshaled from JSON.
+func (b *defaultsBuilder) literal(decl string, s Schema, def interface{}) (string, error) {
+	if strings.HasPrefix(decl, "[]") && s.ArrayType != nil {
+		values, ok := def.([]interface{})
+		if !ok {
+			return "", fmt.Errorf("default %v isn't an array", def)
+		}
+		items := make([]string, len(values))
+		for i, value := range values {
+			item, err := b.literal(s.ArrayType.TypeDecl(), *s.ArrayType, value)
+			if err != nil || item == "" {
+				return "", err
+			}
+			items[i] = item
+		}
+		return decl + "{" + strings.Join(items, ", ") + "}", nil
+	}
+
+	underlying := b.underlying(decl, 0)
+	var lit string
+	switch {
+	case underlying == "string":
+		str, ok := def.(string)
+		if !ok {
+			return "", fmt.Errorf("default %v isn't a string", def)
+		}
+		lit = strconv.Quote(str)
+	case underlying == "bool":
+		value, ok := def.(bool)
+		if !ok {
+			return "", fmt.Errorf("default %v isn't a boolean", def)
+		}
+		lit = strconv.FormatBool(value)
+	case isNumericGoType(underlying):
+		value, ok := def.(float64)
+		if !ok {
+			return "", fmt.Errorf("default %v isn't a number", def)
+		}
+		if strings.HasPrefix(underlying, "float") {
+			lit = strconv.FormatFloat(value, 'g', -1, 64)
+		} else if value != math.Trunc(value) {
+			return "", fmt.Errorf("default %v isn't an integer", def)
+		} else {
+			lit = strconv.FormatInt(int64(value), 10)
+		}
+		// The type of an untyped numeric constant depends on how it's
+		// written, so it's always converted
+		return decl + "(" + lit + ")", nil
+	default:
+		return "", nil
+	}
+
+	// The types of untyped constants are only right for these
+	switch decl {
+	case "string", "bool":
+		return lit, nil
+	}
+	return decl + "(" + lit + ")", nil
+}
+
+// zeroValue returns the zero value of the type decl, if it's a string,
+// boolean or number, or "" otherwise.
+func (b *defaultsBuilder) zeroValue(decl string) string {
+	switch underlying := b.underlying(decl, 0); {
+	case underlying == "string":
+		return `""`
+	case underlying == "bool":
+		return "false"
+	case isNumericGoType(underlying):
+		return "0"
+	}
+	return ""
+}
+
+// underlying returns the predeclared type underlying the type decl, when it's
+// defined in the package, or decl itself otherwise.
+func (b *defaultsBuilder) underlying(decl string, depth int) string {
+	td, ok := b.local[decl]
+	if !ok || depth > maxDefaultsDepth {
+		return decl
+	}
+	return b.underlying(td.Schema.TypeDecl(), depth+1)
+}
+
+func (b *defaultsBuilder) newVar(prefix string) string {
+	name := fmt.Sprintf("%s%d", prefix, b.vars)
+	b.vars++
+	return name
+}
+
+// deref returns the pointer which expr dereferences, if it's of the form
+// `(*p)`, as the methods and fields of the value it points to can be used
+// through the pointer itself.
+func deref(expr string) string {
+	if strings.HasPrefix(expr, "(*") && strings.HasSuffix(expr, ")") && strings.Count(expr, ")") == 1 {
+		return expr[2 : len(expr)-1]
+	}
+	return expr
+}
+
+// quoteRaw returns s as a raw string literal, if it can be written as one,
+// which is more readable for JSON.
+func quoteRaw(s string) string {
+	if strings.Contains(s, "`") {
+		return strconv.Quote(s)
+	}
+	return "`" + s + "`"
+}
@@ -0,0 +1,126 @@
+package codegen
+
+import (
+	"go/format"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const defaultsSpec = `
+openapi: "3.0.0"
+info:
+  version: 1.0.0
+  title: Defaults
+paths:
+  /pets:
+    get:
+      operationId: listPets
+      parameters:
+        - name: limit
+          in: query
+          schema:
+            type: integer
+            default: 20
+        - name: offset
+          in: query
+          required: true
+          schema:
+            type: integer
+            default: 5
+      responses:
+        '204':
+          description: ok
+components:
+  schemas:
+    Pet:
+      type: object
+      required: [name]
+      properties:
+        name:
+          type: string
+          default: ignored
+        kind:
+          type: string
+          default: dog
+        age:
+          type: integer
+          format: int32
+          default: 1
+        toys:
+          type: array
+          items:
+            $ref: '#/components/schemas/Toy'
+        extra:
+          type: object
+          default: {a: 1}
+          additionalProperties:
+            type: integer
+    Toy:
+      type: object
+      properties:
+        color:
+          type: string
+          default: red
+    NewToy:
+      type: string
+    Plain:
+      type: object
+      properties:
+        name:
+          type: string
+`
+
+func TestGenerateDefaults(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(defaultsSpec))
+	require.NoError(t, err)
+
+	code, err := Generate(swagger, Configuration{
+		PackageName:   "api",
+		Generate:      GenerateOptions{Models: true, ChiServer: true},
+		OutputOptions: OutputOptions{SkipPrune: true, GenerateDefaults: true},
+	})
+	require.NoError(t, err)
+	_, err = format.Source([]byte(code))
+	require.NoError(t, err)
+
+	assert.Contains(t, code, "func NewPet() Pet {\n\tvar t Pet\n\tt.ApplyDefaults()\n\treturn t\n}")
+	assert.Contains(t, code, "\tif t.Age == nil {\n\t\tv0 := int32(1)\n\t\tt.Age = &v0\n\t}\n")
+	assert.Contains(t, code, "\tif t.Kind == nil {\n\t\tv2 := \"dog\"\n\t\tt.Kind = &v2\n\t}\n")
+	assert.Contains(t, code, "\t\tfor i3 := range *t.Toys {\n\t\t\t(*t.Toys)[i3].ApplyDefaults()\n\t\t}\n")
+	assert.Contains(t, code, "if err := json.Unmarshal([]byte(`{\"a\":1}`), &v")
+	assert.Contains(t, code, "func (t *Pet) UnmarshalJSON(data []byte) error {\n\ttype plain Pet\n")
+
+	// Required properties are always present
+	assert.NotContains(t, code, "ignored")
+
+	// The constructor of Toy would conflict with the NewToy type
+	assert.Contains(t, code, "func (t *Toy) ApplyDefaults() {")
+	assert.NotContains(t, code, "func NewToy()")
+
+	// Types without defaults don't get the methods
+	assert.NotContains(t, code, "func (t *Plain) ApplyDefaults()")
+
+	// Only the defaults of optional parameters are applied, by the server
+	// wrappers
+	assert.Contains(t, code, "func (t *ListPetsParams) ApplyDefaults() {\n\tif t.Limit == nil {\n\t\tv0 := int(20)\n\t\tt.Limit = &v0\n\t}\n}")
+	assert.NotContains(t, code, "func (t *ListPetsParams) UnmarshalJSON(")
+	assert.Contains(t, code, "\tparams.ApplyDefaults()\n")
+}
+
+func TestGenerateDefaultsDisabled(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(defaultsSpec))
+	require.NoError(t, err)
+
+	code, err := Generate(swagger, Configuration{
+		PackageName:   "api",
+		Generate:      GenerateOptions{Models: true, ChiServer: true},
+		OutputOptions: OutputOptions{SkipPrune: true},
+	})
+	require.NoError(t, err)
+
+	assert.NotContains(t, code, "ApplyDefaults")
+	assert.NotContains(t, code, "func NewPet()")
+}
@@ -0,0 +1,469 @@
+package codegen
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// SpecChangeKind identifies the kind of a SpecChange.
+type SpecChangeKind string
+
+const (
+	SpecChangeOperationAdded         SpecChangeKind = "operation-added"
+	SpecChangeOperationRemoved       SpecChangeKind = "operation-removed"
+	SpecChangeOperationMoved         SpecChangeKind = "operation-moved"
+	SpecChangeParameterAdded         SpecChangeKind = "parameter-added"
+	SpecChangeParameterRemoved       SpecChangeKind = "parameter-removed"
+	SpecChangeParameterRequired      SpecChangeKind = "parameter-required"
+	SpecChangeParameterTypeChanged   SpecChangeKind = "parameter-type-changed"
+	SpecChangeRequestBodyAdded       SpecChangeKind = "request-body-added"
+	SpecChangeRequestBodyRemoved     SpecChangeKind = "request-body-removed"
+	SpecChangeRequestBodyRequired    SpecChangeKind = "request-body-required"
+	SpecChangeRequestBodyTypeChanged SpecChangeKind = "request-body-type-changed"
+	SpecChangeResponseAdded          SpecChangeKind = "response-added"
+	SpecChangeResponseRemoved        SpecChangeKind = "response-removed"
+	SpecChangeResponseTypeChanged    SpecChangeKind = "response-type-changed"
+	SpecChangeTypeAdded              SpecChangeKind = "type-added"
+	SpecChangeTypeRemoved            SpecChangeKind = "type-removed"
+	SpecChangeTypeRenamed            SpecChangeKind = "type-renamed"
+	SpecChangeTypeChanged            SpecChangeKind = "type-changed"
+	SpecChangeFieldAdded             SpecChangeKind = "field-added"
+	SpecChangeFieldRemoved           SpecChangeKind = "field-removed"
+	SpecChangeFieldRequired          SpecChangeKind = "field-required"
+	SpecChangeFieldTypeChanged       SpecChangeKind = "field-type-changed"
+	SpecChangeEnumValueAdded         SpecChangeKind = "enum-value-added"
+	SpecChangeEnumValueRemoved       SpecChangeKind = "enum-value-removed"
+)
+
+// SpecChange is a change between two versions of a spec, as seen by the code
+// generated for them.
+type SpecChange struct {
+	// Kind is the kind of change
+	Kind SpecChangeKind `json:"kind"`
+	// Breaking is whether the change breaks code which uses the code
+	// generated for the old spec, or clients and servers of the old spec on
+	// the wire
+	Breaking bool `json:"breaking"`
+	// Location is the operation or type which changed, along with the part of
+	// it, such as Pet.name
+	Location string `json:"location"`
+	// Message describes the change
+	Message string `json:"message"`
+}
+
+// DiffSpecs compares two versions of a spec, as code is generated for them with
+// the given Configuration, and returns the changes from oldSpec to newSpec.
+// Operations are compared by their Go names, along with their parameters,
+// request bodies and responses, and the types of the component schemas by
+// their Go names, along with their fields and enum values.
+//
+// Both specs are normalized, filtered and pruned as when generating code, so
+// are modified.
+func DiffSpecs(oldSpec, newSpec *openapi3.T, opts Configuration) ([]SpecChange, error) {
+	oldAPI, err := describeAPI(oldSpec, opts)
+	if err != nil {
+		return nil, fmt.Errorf("error describing the old spec: %w", err)
+	}
+	newAPI, err := describeAPI(newSpec, opts)
+	if err != nil {
+		return nil, fmt.Errorf("error describing the new spec: %w", err)
+	}
+
+	var d specDiff
+	d.diffOperations(oldAPI.operations, newAPI.operations)
+	d.diffTypes(oldAPI.types, newAPI.types)
+	return d.changes, nil
+}
+
+// apiDescription is the code generated for a spec, as far as it's compared by
+// DiffSpecs.
+type apiDescription struct {
+	// operations are keyed by operation ID
+	operations map[string]OperationDefinition
+	// types are keyed by type name
+	types map[string]TypeDefinition
+}
+
+func describeAPI(spec *openapi3.T, opts Configuration) (*apiDescription, error) {
+	if err := prepareSpec(spec, opts); err != nil {
+		return nil, err
+	}
+	g, err := NewGenerator(spec, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	ops, err := g.OperationDefinitions(spec, opts.OutputOptions.InitialismOverrides)
+	if err != nil {
+		return nil, fmt.Errorf("error creating operation definitions: %w", err)
+	}
+	var types []TypeDefinition
+	if spec.Components != nil {
+		types, err = g.GenerateTypesForSchemas(nil, spec.Components.Schemas, opts.OutputOptions.ExcludeSchemas)
+		if err != nil {
+			return nil, fmt.Errorf("error generating types for schemas: %w", err)
+		}
+	}
+
+	api := &apiDescription{
+		operations: make(map[string]OperationDefinition, len(ops)),
+		types:      make(map[string]TypeDefinition, len(types)),
+	}
+	for _, op := range ops {
+		api.operations[op.OperationId] = op
+	}
+	for _, td := range types {
+		api.types[td.TypeName] = td
+	}
+	return api, nil
+}
+
+// specDiff collects the changes found by DiffSpecs, in the order they're found.
+type specDiff struct {
+	changes []SpecChange
+}
+
+func (d *specDiff) add(kind SpecChangeKind, breaking bool, location string, format string, args ...interface{}) {
+	d.changes = append(d.changes, SpecChange{
+		Kind:     kind,
+		Breaking: breaking,
+		Location: location,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+// pathParamPattern matches the path parameters of a path, whose names don't
+// affect where an operation is served.
+var pathParamPattern = regexp.MustCompile(`\{[^}]*\}`)
+
+func (d *specDiff) diffOperations(oldOps, newOps map[string]OperationDefinition) {
+	for _, id := range SortedMapKeys(oldOps) {
+		oldOp := oldOps[id]
+		newOp, ok := newOps[id]
+		if !ok {
+			d.add(SpecChangeOperationRemoved, true, id, "%s %s was removed", oldOp.Method, oldOp.Path)
+			continue
+		}
+
+		if oldOp.Method != newOp.Method || pathParamPattern.ReplaceAllString(oldOp.Path, "{}") != pathParamPattern.ReplaceAllString(newOp.Path, "{}") {
+			d.add(SpecChangeOperationMoved, true, id, "moved from %s %s to %s %s", oldOp.Method, oldOp.Path, newOp.Method, newOp.Path)
+		}
+		d.diffPathParameters(id, oldOp.PathParams, newOp.PathParams)
+		d.diffParameters(id, oldOp.Params(), newOp.Params())
+		d.diffRequestBodies(id, oldOp, newOp)
+		d.diffResponses(id, oldOp.Responses, newOp.Responses)
+	}
+
+	for _, id := range SortedMapKeys(newOps) {
+		if _, ok := oldOps[id]; !ok {
+			d.add(SpecChangeOperationAdded, false, id, "%s %s was added", newOps[id].Method, newOps[id].Path)
+		}
+	}
+}
+
+// diffPathParameters compares the path parameters of an operation by their
+// position, as renaming them doesn't affect the generated code, or the wire.
+// Adding or removing them moves the operation.
+func (d *specDiff) diffPathParameters(opID string, oldParams, newParams []ParameterDefinition) {
+	for i := 0; i < len(oldParams) && i < len(newParams); i++ {
+		location := opID + ": path parameter " + newParams[i].ParamName
+		if oldType, newType := oldParams[i].TypeDef(), newParams[i].TypeDef(); oldType != newType {
+			d.add(SpecChangeParameterTypeChanged, true, location, "type changed from %s to %s", shortTypeDecl(oldType), shortTypeDecl(newType))
+		}
+		d.diffEnumValues(location, oldParams[i].Schema, newParams[i].Schema)
+	}
+}
+
+func (d *specDiff) diffParameters(opID string, oldParams, newParams []ParameterDefinition) {
+	key := func(p ParameterDefinition) string {
+		return p.In + " parameter " + p.ParamName
+	}
+	oldByKey := make(map[string]ParameterDefinition, len(oldParams))
+	for _, p := range oldParams {
+		oldByKey[key(p)] = p
+	}
+	newByKey := make(map[string]ParameterDefinition, len(newParams))
+	for _, p := range newParams {
+		newByKey[key(p)] = p
+	}
+
+	for _, k := range SortedMapKeys(oldByKey) {
+		oldParam := oldByKey[k]
+		location := opID + ": " + k
+		newParam, ok := newByKey[k]
+		if !ok {
+			d.add(SpecChangeParameterRemoved, true, location, "parameter was removed")
+			continue
+		}
+
+		oldType, newType := parameterGoType(oldParam), parameterGoType(newParam)
+		if !oldParam.Required && newParam.Required {
+			d.add(SpecChangeParameterRequired, true, location, "parameter became required")
+		} else if oldType != newType {
+			d.add(SpecChangeParameterTypeChanged, true, location, "type changed from %s to %s", shortTypeDecl(oldType), shortTypeDecl(newType))
+		}
+		d.diffEnumValues(location, oldParam.Schema, newParam.Schema)
+	}
+
+	for _, k := range SortedMapKeys(newByKey) {
+		if _, ok := oldByKey[k]; ok {
+			continue
+		}
+		if newByKey[k].Required {
+			d.add(SpecChangeParameterAdded, true, opID+": "+k, "required parameter was added")
+		} else {
+			d.add(SpecChangeParameterAdded, false, opID+": "+k, "optional parameter was added")
+		}
+	}
+}
+
+// parameterGoType returns the Go type of a parameter, as a field of the
+// parameters of its operation.
+func parameterGoType(p ParameterDefinition) string {
+	if p.Required || p.Schema.SkipOptionalPointer {
+		return p.TypeDef()
+	}
+	return "*" + p.TypeDef()
+}
+
+func (d *specDiff) diffRequestBodies(opID string, oldOp, newOp OperationDefinition) {
+	oldHasBody, newHasBody := oldOp.Spec.RequestBody != nil, newOp.Spec.RequestBody != nil
+	switch {
+	case oldHasBody && !newHasBody:
+		d.add(SpecChangeRequestBodyRemoved, true, opID+": request body", "request body was removed")
+		return
+	case !oldHasBody && newHasBody:
+		d.add(SpecChangeRequestBodyAdded, true, opID+": request body", "request body was added")
+		return
+	case !oldHasBody:
+		return
+	}
+
+	if !oldOp.BodyRequired && newOp.BodyRequired {
+		d.add(SpecChangeRequestBodyRequired, true, opID+": request body", "request body became required")
+	}
+
+	newBodies := make(map[string]RequestBodyDefinition, len(newOp.Bodies))
+	for _, body := range newOp.Bodies {
+		newBodies[body.ContentType] = body
+	}
+	oldBodies := make(map[string]RequestBodyDefinition, len(oldOp.Bodies))
+	for _, oldBody := range oldOp.Bodies {
+		oldBodies[oldBody.ContentType] = oldBody
+		location := opID + ": " + oldBody.ContentType + " request body"
+		newBody, ok := newBodies[oldBody.ContentType]
+		if !ok {
+			d.add(SpecChangeRequestBodyRemoved, true, location, "request body was removed")
+			continue
+		}
+		if oldType, newType := oldBody.Schema.TypeDecl(), newBody.Schema.TypeDecl(); oldType != newType {
+			d.add(SpecChangeRequestBodyTypeChanged, true, location, "type changed from %s to %s", shortTypeDecl(oldType), shortTypeDecl(newType))
+		}
+	}
+	for _, newBody := range newOp.Bodies {
+		if _, ok := oldBodies[newBody.ContentType]; !ok {
+			d.add(SpecChangeRequestBodyAdded, false, opID+": "+newBody.ContentType+" request body", "request body was added")
+		}
+	}
+}
+
+func (d *specDiff) diffResponses(opID string, oldResponses, newResponses []ResponseDefinition) {
+	newByStatus := make(map[string]ResponseDefinition, len(newResponses))
+	for _, r := range newResponses {
+		newByStatus[r.StatusCode] = r
+	}
+	oldByStatus := make(map[string]ResponseDefinition, len(oldResponses))
+	for _, oldResponse := range oldResponses {
+		oldByStatus[oldResponse.StatusCode] = oldResponse
+		location := opID + ": " + oldResponse.StatusCode + " response"
+		newResponse, ok := newByStatus[oldResponse.StatusCode]
+		if !ok {
+			d.add(SpecChangeResponseRemoved, true, location, "response was removed")
+			continue
+		}
+
+		newContents := make(map[string]ResponseContentDefinition, len(newResponse.Contents))
+		for _, c := range newResponse.Contents {
+			newContents[c.ContentType] = c
+		}
+		for _, oldContent := range oldResponse.Contents {
+			location := opID + ": " + oldResponse.StatusCode + " " + oldContent.ContentType + " response"
+			newContent, ok := newContents[oldContent.ContentType]
+			if !ok {
+				d.add(SpecChangeResponseRemoved, true, location, "response was removed")
+				continue
+			}
+			if oldType, newType := responseGoType(oldContent), responseGoType(newContent); oldType != newType {
+				d.add(SpecChangeResponseTypeChanged, true, location, "type changed from %s to %s", shortTypeDecl(oldType), shortTypeDecl(newType))
+			}
+		}
+	}
+	for _, newResponse := range newResponses {
+		if _, ok := oldByStatus[newResponse.StatusCode]; !ok {
+			d.add(SpecChangeResponseAdded, false, opID+": "+newResponse.StatusCode+" response", "response was added")
+		}
+	}
+}
+
+// responseGoType returns the Go type of the body of a response, or of its
+// items if it's streamed.
+func responseGoType(c ResponseContentDefinition) string {
+	switch {
+	case c.IsStream():
+		return c.ItemSchema.TypeDecl()
+	case c.IsSupported():
+		return c.Schema.TypeDecl()
+	default:
+		return "io.Reader"
+	}
+}
+
+func (d *specDiff) diffTypes(oldTypes, newTypes map[string]TypeDefinition) {
+	var removed, added []string
+	for _, name := range SortedMapKeys(oldTypes) {
+		if newType, ok := newTypes[name]; ok {
+			d.diffType(name, oldTypes[name], newType)
+		} else {
+			removed = append(removed, name)
+		}
+	}
+	for _, name := range SortedMapKeys(newTypes) {
+		if _, ok := oldTypes[name]; !ok {
+			added = append(added, name)
+		}
+	}
+
+	// A removed type is taken to be renamed when exactly one type with the
+	// same definition was added, and no other type with that definition was
+	// removed.
+	signatures := func(types map[string]TypeDefinition, names []string) map[string][]string {
+		res := map[string][]string{}
+		for _, name := range names {
+			if sig := typeSignature(types[name]); sig != "" {
+				res[sig] = append(res[sig], name)
+			}
+		}
+		return res
+	}
+	removedBySig, addedBySig := signatures(oldTypes, removed), signatures(newTypes, added)
+	renamed := map[string]bool{}
+	for _, name := range removed {
+		sig := typeSignature(oldTypes[name])
+		if len(removedBySig[sig]) == 1 && len(addedBySig[sig]) == 1 {
+			newName := addedBySig[sig][0]
+			renamed[name], renamed[newName] = true, true
+			d.add(SpecChangeTypeRenamed, true, name, "type was renamed to %s", newName)
+		}
+	}
+
+	for _, name := range removed {
+		if !renamed[name] {
+			d.add(SpecChangeTypeRemoved, true, name, "type was removed")
+		}
+	}
+	for _, name := range added {
+		if !renamed[name] {
+			d.add(SpecChangeTypeAdded, false, name, "type was added")
+		}
+	}
+}
+
+// typeSignature identifies the definition of a type, regardless of its name,
+// or returns an empty string for types which are too simple to tell apart,
+// such as aliases of string.
+func typeSignature(td TypeDefinition) string {
+	if len(td.Schema.EnumValues) > 0 {
+		return td.Schema.TypeDecl() + " " + strings.Join(enumValues(td.Schema), ",")
+	}
+	if isStructType(td.Schema) {
+		return td.Schema.TypeDecl()
+	}
+	return ""
+}
+
+// shortTypeDecl shortens the declaration of an inline struct, whose fields are
+// compared separately, for the messages of changes.
+func shortTypeDecl(decl string) string {
+	if i := strings.Index(decl, "struct {"); i >= 0 {
+		return decl[:i] + "struct{...}"
+	}
+	return decl
+}
+
+func isStructType(s Schema) bool {
+	return strings.HasPrefix(s.TypeDecl(), "struct")
+}
+
+func (d *specDiff) diffType(name string, oldType, newType TypeDefinition) {
+	if isStructType(oldType.Schema) && isStructType(newType.Schema) {
+		d.diffFields(name, oldType.Schema.Properties, newType.Schema.Properties)
+	} else if oldDecl, newDecl := oldType.Schema.TypeDecl(), newType.Schema.TypeDecl(); oldDecl != newDecl {
+		d.add(SpecChangeTypeChanged, true, name, "type changed from %s to %s", shortTypeDecl(oldDecl), shortTypeDecl(newDecl))
+	}
+	d.diffEnumValues(name, oldType.Schema, newType.Schema)
+}
+
+func (d *specDiff) diffFields(typeName string, oldProps, newProps []Property) {
+	newByName := make(map[string]Property, len(newProps))
+	for _, p := range newProps {
+		newByName[p.JsonFieldName] = p
+	}
+	oldByName := make(map[string]Property, len(oldProps))
+	for _, oldProp := range oldProps {
+		oldByName[oldProp.JsonFieldName] = oldProp
+		location := typeName + "." + oldProp.JsonFieldName
+		newProp, ok := newByName[oldProp.JsonFieldName]
+		if !ok {
+			d.add(SpecChangeFieldRemoved, true, location, "field was removed")
+			continue
+		}
+
+		if !oldProp.Required && newProp.Required {
+			d.add(SpecChangeFieldRequired, true, location, "field became required")
+		} else if oldDef, newDef := oldProp.GoTypeDef(), newProp.GoTypeDef(); oldDef != newDef {
+			d.add(SpecChangeFieldTypeChanged, true, location, "type changed from %s to %s", shortTypeDecl(oldDef), shortTypeDecl(newDef))
+		}
+	}
+	for _, newProp := range newProps {
+		if _, ok := oldByName[newProp.JsonFieldName]; ok {
+			continue
+		}
+		location := typeName + "." + newProp.JsonFieldName
+		if newProp.Required {
+			d.add(SpecChangeFieldAdded, true, location, "required field was added")
+		} else {
+			d.add(SpecChangeFieldAdded, false, location, "optional field was added")
+		}
+	}
+}
+
+func (d *specDiff) diffEnumValues(location string, oldSchema, newSchema Schema) {
+	if len(oldSchema.EnumValues) == 0 {
+		return
+	}
+	oldValues, newValues := enumValues(oldSchema), enumValues(newSchema)
+	for _, v := range oldValues {
+		if !sliceContains(newValues, v) {
+			d.add(SpecChangeEnumValueRemoved, true, location, "enum value %s was removed", v)
+		}
+	}
+	for _, v := range newValues {
+		if !sliceContains(oldValues, v) {
+			d.add(SpecChangeEnumValueAdded, false, location, "enum value %s was added", v)
+		}
+	}
+}
+
+// enumValues returns the values of an enum, sorted.
+func enumValues(s Schema) []string {
+	values := make([]string, 0, len(s.EnumValues))
+	for _, v := range s.EnumValues {
+		values = append(values, v)
+	}
+	sort.Strings(values)
+	return values
+}
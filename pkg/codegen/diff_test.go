@@ -0,0 +1,295 @@
+package codegen
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const diffOldSpec = `
+openapi: "3.0.0"
+info:
+  version: 1.0.0
+  title: Pets
+paths:
+  /pets:
+    get:
+      operationId: findPets
+      parameters:
+        - name: limit
+          in: query
+          schema:
+            type: integer
+        - name: kind
+          in: query
+          schema:
+            type: string
+            enum: [cat, dog, fish]
+      responses:
+        '200':
+          description: Pets
+          content:
+            application/json:
+              schema:
+                type: array
+                items:
+                  $ref: '#/components/schemas/Pet'
+    post:
+      operationId: addPet
+      requestBody:
+        content:
+          application/json:
+            schema:
+              $ref: '#/components/schemas/Pet'
+      responses:
+        '201':
+          description: Added
+        '400':
+          description: Invalid
+  /pets/{id}:
+    get:
+      operationId: getPet
+      parameters:
+        - name: id
+          in: path
+          required: true
+          schema:
+            type: string
+      responses:
+        '200':
+          description: A pet
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/Pet'
+    delete:
+      operationId: deletePet
+      parameters:
+        - name: id
+          in: path
+          required: true
+          schema:
+            type: string
+      responses:
+        '204':
+          description: Deleted
+  /toys:
+    get:
+      operationId: listToys
+      responses:
+        '200':
+          description: Toys
+          content:
+            application/json:
+              schema:
+                type: array
+                items:
+                  $ref: '#/components/schemas/Toy'
+components:
+  schemas:
+    Pet:
+      type: object
+      required: [name]
+      properties:
+        name:
+          type: string
+        age:
+          type: integer
+        owner:
+          type: string
+        tag:
+          type: string
+    Toy:
+      type: object
+      properties:
+        squeaks:
+          type: boolean
+`
+
+const diffNewSpec = `
+openapi: "3.0.0"
+info:
+  version: 2.0.0
+  title: Pets
+paths:
+  /pets:
+    get:
+      operationId: findPets
+      parameters:
+        - name: limit
+          in: query
+          required: true
+          schema:
+            type: integer
+        - name: kind
+          in: query
+          schema:
+            type: string
+            enum: [cat, dog, bird]
+        - name: page
+          in: query
+          schema:
+            type: string
+      responses:
+        '200':
+          description: Pets
+          content:
+            application/json:
+              schema:
+                type: array
+                items:
+                  $ref: '#/components/schemas/Pet'
+    post:
+      operationId: addPet
+      requestBody:
+        required: true
+        content:
+          application/json:
+            schema:
+              $ref: '#/components/schemas/Pet'
+      responses:
+        '201':
+          description: Added
+  /pets/{petId}:
+    get:
+      operationId: getPet
+      parameters:
+        - name: petId
+          in: path
+          required: true
+          schema:
+            type: string
+      responses:
+        '200':
+          description: A pet
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/Pet'
+        '404':
+          description: Not found
+  /stores:
+    get:
+      operationId: listStores
+      responses:
+        '200':
+          description: Stores
+          content:
+            application/json:
+              schema:
+                type: array
+                items:
+                  $ref: '#/components/schemas/Plaything'
+  /toys:
+    get:
+      operationId: listToys
+      responses:
+        '200':
+          description: Toys
+          content:
+            application/json:
+              schema:
+                type: array
+                items:
+                  $ref: '#/components/schemas/Plaything'
+components:
+  schemas:
+    Pet:
+      type: object
+      required: [name, owner]
+      properties:
+        name:
+          type: string
+        age:
+          type: string
+        owner:
+          type: string
+        color:
+          type: string
+    Plaything:
+      type: object
+      properties:
+        squeaks:
+          type: boolean
+`
+
+func diffTestSpecs(t *testing.T) (*openapi3.T, *openapi3.T) {
+	t.Helper()
+	oldSpec, err := openapi3.NewLoader().LoadFromData([]byte(diffOldSpec))
+	require.NoError(t, err)
+	newSpec, err := openapi3.NewLoader().LoadFromData([]byte(diffNewSpec))
+	require.NoError(t, err)
+	return oldSpec, newSpec
+}
+
+func TestDiffSpecs(t *testing.T) {
+	oldSpec, newSpec := diffTestSpecs(t)
+
+	changes, err := DiffSpecs(oldSpec, newSpec, Configuration{PackageName: "api"})
+	require.NoError(t, err)
+
+	expected := []SpecChange{
+		{SpecChangeRequestBodyRequired, true, "AddPet: request body", "request body became required"},
+		{SpecChangeResponseRemoved, true, "AddPet: 400 response", "response was removed"},
+		{SpecChangeOperationRemoved, true, "DeletePet", "DELETE /pets/{id} was removed"},
+		{SpecChangeEnumValueRemoved, true, "FindPets: query parameter kind", "enum value fish was removed"},
+		{SpecChangeEnumValueAdded, false, "FindPets: query parameter kind", "enum value bird was added"},
+		{SpecChangeParameterRequired, true, "FindPets: query parameter limit", "parameter became required"},
+		{SpecChangeParameterAdded, false, "FindPets: query parameter page", "optional parameter was added"},
+		{SpecChangeResponseAdded, false, "GetPet: 404 response", "response was added"},
+		{SpecChangeResponseTypeChanged, true, "ListToys: 200 application/json response", "type changed from []Toy to []Plaything"},
+		{SpecChangeOperationAdded, false, "ListStores", "GET /stores was added"},
+		{SpecChangeFieldTypeChanged, true, "Pet.age", "type changed from *int to *string"},
+		{SpecChangeFieldRequired, true, "Pet.owner", "field became required"},
+		{SpecChangeFieldRemoved, true, "Pet.tag", "field was removed"},
+		{SpecChangeFieldAdded, false, "Pet.color", "optional field was added"},
+		{SpecChangeTypeRenamed, true, "Toy", "type was renamed to Plaything"},
+	}
+	assert.Equal(t, expected, changes)
+}
+
+func TestDiffSpecsUnchanged(t *testing.T) {
+	oldSpec, _ := diffTestSpecs(t)
+	sameSpec, _ := diffTestSpecs(t)
+
+	changes, err := DiffSpecs(oldSpec, sameSpec, Configuration{PackageName: "api"})
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+}
+
+func TestDiffSpecsEnumValuesRemoved(t *testing.T) {
+	oldSpec, _ := diffTestSpecs(t)
+	newSpec, _ := diffTestSpecs(t)
+	oldSpec.Components.Schemas["Status"] = openapi3.NewSchemaRef("", openapi3.NewStringSchema().WithEnum("available", "sold"))
+	// The constants of the values are removed along with the enum
+	newSpec.Components.Schemas["Status"] = openapi3.NewSchemaRef("", openapi3.NewStringSchema())
+
+	changes, err := DiffSpecs(oldSpec, newSpec, Configuration{PackageName: "api", OutputOptions: OutputOptions{SkipPrune: true}})
+	require.NoError(t, err)
+
+	expected := []SpecChange{
+		{SpecChangeEnumValueRemoved, true, "Status", "enum value available was removed"},
+		{SpecChangeEnumValueRemoved, true, "Status", "enum value sold was removed"},
+	}
+	assert.Equal(t, expected, changes)
+}
+
+func TestDiffSpecsConfiguration(t *testing.T) {
+	oldSpec, newSpec := diffTestSpecs(t)
+
+	// Operations are filtered as when generating code
+	opts := Configuration{
+		PackageName: "api",
+		OutputOptions: OutputOptions{
+			ExcludeOperationIDs: []string{"deletePet", "listStores"},
+		},
+	}
+	changes, err := DiffSpecs(oldSpec, newSpec, opts)
+	require.NoError(t, err)
+	for _, change := range changes {
+		assert.NotEqual(t, "DeletePet", change.Location)
+		assert.NotEqual(t, "ListStores", change.Location)
+	}
+}
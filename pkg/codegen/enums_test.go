@@ -0,0 +1,124 @@
+package codegen
+
+import (
+	"go/format"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const enumsSpec = `
+openapi: "3.0.0"
+info:
+  version: 1.0.0
+  title: Enums
+paths: {}
+components:
+  schemas:
+    PetStatus:
+      type: string
+      enum: [available, pending, sold]
+    Priority:
+      type: integer
+      format: int32
+      enum: [3, 1, 2]
+    Ratio:
+      type: number
+      enum: [0.5, 1.5]
+`
+
+func TestGenerateEnumHelpers(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(enumsSpec))
+	require.NoError(t, err)
+
+	code, err := Generate(swagger, Configuration{
+		PackageName:   "api",
+		Generate:      GenerateOptions{Models: true},
+		OutputOptions: OutputOptions{SkipPrune: true, EnumHelpers: true},
+	})
+	require.NoError(t, err)
+	_, err = format.Source([]byte(code))
+	require.NoError(t, err)
+
+	assert.Contains(t, code, "func PetStatusValues() []PetStatus {\n\treturn []PetStatus{\n\t\tAvailable,\n\t\tPending,\n\t\tSold,\n\t}\n}")
+	assert.Contains(t, code, "func (e PetStatus) Valid() bool {\n\tswitch e {\n\tcase Available, Pending, Sold:\n")
+	assert.Contains(t, code, "func (e PetStatus) String() string {\n\treturn string(e)\n}")
+	assert.Contains(t, code, "func ParsePetStatus(s string) (PetStatus, error) {\n\te := PetStatus(s)\n")
+
+	// Integer values are listed in the order they're declared in
+	assert.Contains(t, code, "\treturn []Priority{\n\t\tN3,\n\t\tN1,\n\t\tN2,\n\t}\n")
+	assert.Contains(t, code, "func (e Priority) String() string {\n\treturn strconv.FormatInt(int64(e), 10)\n}")
+	assert.Contains(t, code, "\tv, err := strconv.ParseInt(s, 10, 32)\n")
+
+	// Only string and integer enums get helpers
+	assert.NotContains(t, code, "func RatioValues()")
+
+	assert.NotContains(t, code, "UnmarshalJSON")
+}
+
+func TestGenerateStrictEnumUnmarshal(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(enumsSpec))
+	require.NoError(t, err)
+
+	code, err := Generate(swagger, Configuration{
+		PackageName:   "api",
+		Generate:      GenerateOptions{Models: true},
+		OutputOptions: OutputOptions{SkipPrune: true, EnumHelpers: true, StrictEnumUnmarshal: true},
+	})
+	require.NoError(t, err)
+
+	assert.Contains(t, code, "func (e *PetStatus) UnmarshalJSON(data []byte) error {\n\tif string(data) == \"null\" {\n\t\treturn nil\n\t}\n\tvar v string\n")
+	assert.Contains(t, code, "func (e *Priority) UnmarshalJSON(data []byte) error {\n\tif string(data) == \"null\" {\n\t\treturn nil\n\t}\n\tvar v int32\n")
+	assert.Contains(t, code, "func (e *PetStatus) UnmarshalText(text []byte) error {\n\tv, err := ParsePetStatus(string(text))\n")
+}
+
+func TestGenerateEnumsWithoutHelpers(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(enumsSpec))
+	require.NoError(t, err)
+
+	// The helpers are only generated when opted in to
+	code, err := Generate(swagger, Configuration{
+		PackageName:   "api",
+		Generate:      GenerateOptions{Models: true},
+		OutputOptions: OutputOptions{SkipPrune: true},
+	})
+	require.NoError(t, err)
+
+	assert.Contains(t, code, "Available PetStatus = \"available\"")
+	assert.NotContains(t, code, "Valid()")
+	assert.NotContains(t, code, "ParsePetStatus")
+}
+
+func TestEnumHelperConflicts(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(enumsSpec))
+	require.NoError(t, err)
+	swagger.Components.Schemas["PetStatusValues"] = openapi3.NewSchemaRef("", openapi3.NewStringSchema())
+
+	_, err = Generate(swagger, Configuration{
+		PackageName:   "api",
+		Generate:      GenerateOptions{Models: true},
+		OutputOptions: OutputOptions{SkipPrune: true, EnumHelpers: true},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "the helper PetStatusValues of enum PetStatus conflicts with a type or enum value of the same name")
+
+	// Without the helpers, there's nothing to conflict
+	_, err = Generate(swagger, Configuration{
+		PackageName:   "api",
+		Generate:      GenerateOptions{Models: true},
+		OutputOptions: OutputOptions{SkipPrune: true},
+	})
+	require.NoError(t, err)
+}
+
+func TestStrictEnumUnmarshalRequiresEnumHelpers(t *testing.T) {
+	err := Configuration{
+		PackageName:   "api",
+		Generate:      GenerateOptions{Models: true},
+		OutputOptions: OutputOptions{StrictEnumUnmarshal: true},
+	}.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "strict-enum-unmarshal")
+}
@@ -26,6 +26,12 @@ const (
 	// extOapiCodegenOnlyHonourGoName is to be used to explicitly enforce the generation of a field as the `x-go-name` extension has describe it.
 	// This is intended to be used alongside the `allow-unexported-struct-field-names` Compatibility option
 	extOapiCodegenOnlyHonourGoName = "x-oapi-codegen-only-honour-go-name"
+	// extIdempotent marks an operation as idempotent, or not, overriding the
+	// idempotency of its method, which decides whether the client retries it.
+	extIdempotent = "x-idempotent"
+	// extFieldRepresentation overrides the `field-representation` output
+	// option for the properties of an object schema, or for a single property.
+	extFieldRepresentation = "x-go-field-representation"
 )
 
 func extString(extPropValue interface{}) (string, error) {
@@ -111,3 +117,23 @@ func extParseOapiCodegenOnlyHonourGoName(extPropValue interface{}) (bool, error)
 	}
 	return onlyHonourGoName, nil
 }
+
+func extParseIdempotent(extPropValue interface{}) (bool, error) {
+	idempotent, ok := extPropValue.(bool)
+	if !ok {
+		return false, fmt.Errorf("failed to convert type: %T", extPropValue)
+	}
+	return idempotent, nil
+}
+
+func extParseFieldRepresentation(extPropValue interface{}) (FieldRepresentation, error) {
+	str, err := extString(extPropValue)
+	if err != nil {
+		return "", err
+	}
+	representation := FieldRepresentation(str)
+	if !representation.Valid() {
+		return "", fmt.Errorf("unknown field representation %q, expected one of %q", str, FieldRepresentations)
+	}
+	return representation, nil
+}
@@ -0,0 +1,109 @@
+package codegen
+
+import (
+	"fmt"
+	"text/template"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// Generator generates Go code for an OpenAPI specification. It holds all the
+// state needed to generate the code for one spec with one Configuration, so
+// separate Generators can safely be used concurrently.
+//
+// Generating code may modify the spec, for instance when filtering and pruning
+// it, so a spec shouldn't be shared between Generators which are used
+// concurrently.
+type Generator struct {
+	opts          Configuration
+	spec          *openapi3.T
+	importMapping importMap
+
+	// nameNormalizer converts names from the spec into Go names
+	nameNormalizer NameNormalizer
+	// responseTypeSuffix is appended to the names of the response types of
+	// the client with responses
+	responseTypeSuffix string
+
+	// variants contains the references of the component schemas which have
+	// request and response variants, when they're generated
+	variants map[string]bool
+	// variant is the suffix of the variants which the references to those
+	// schemas refer to, if any
+	variant string
+}
+
+// NewGenerator creates a Generator for the given spec and Configuration.
+func NewGenerator(spec *openapi3.T, opts Configuration) (*Generator, error) {
+	return newGenerator(spec, opts, nil)
+}
+
+// newGenerator creates a Generator, as NewGenerator. When models is set, the
+// types for the spec's components are expected to be generated in that
+// package instead, and are referred to from there.
+func newGenerator(spec *openapi3.T, opts Configuration, models *goImport) (*Generator, error) {
+	g := &Generator{
+		opts:               opts,
+		spec:               spec,
+		importMapping:      constructImportMapping(opts.ImportMapping),
+		responseTypeSuffix: "Response",
+	}
+	if models != nil {
+		g.importMapping[importMappingLocalComponents] = *models
+	}
+
+	// if we are provided an override for the response type suffix update it
+	if opts.OutputOptions.ResponseTypeSuffix != "" {
+		g.responseTypeSuffix = opts.OutputOptions.ResponseTypeSuffix
+	}
+
+	if g.opts.OutputOptions.ClientTypeName == "" {
+		g.opts.OutputOptions.ClientTypeName = defaultClientTypeName
+	}
+
+	nameNormalizerFunction := NameNormalizerFunction(opts.OutputOptions.NameNormalizer)
+	g.nameNormalizer = NameNormalizers[nameNormalizerFunction]
+	if g.nameNormalizer == nil {
+		return nil, fmt.Errorf(`the name-normalizer option %v could not be found among options %q`,
+			opts.OutputOptions.NameNormalizer, NameNormalizers.Options())
+	}
+
+	return g, nil
+}
+
+// defaultGenerator uses the default Configuration, and backs the package level
+// functions which predate Generator, as well as any values which weren't
+// created by a Generator.
+var defaultGenerator = &Generator{
+	opts: Configuration{
+		OutputOptions: OutputOptions{
+			ClientTypeName: defaultClientTypeName,
+		},
+	},
+	importMapping:      importMap{},
+	nameNormalizer:     ToCamelCase,
+	responseTypeSuffix: "Response",
+}
+
+// orDefault returns g, or the default Generator if g is nil.
+func (g *Generator) orDefault() *Generator {
+	if g == nil {
+		return defaultGenerator
+	}
+	return g
+}
+
+// templateFunctions returns the functions available to the templates, bound to
+// this Generator.
+func (g *Generator) templateFunctions() template.FuncMap {
+	funcs := make(template.FuncMap, len(TemplateFunctions)+1)
+	for k, v := range TemplateFunctions {
+		funcs[k] = v
+	}
+
+	funcs["opts"] = func() Configuration { return g.opts }
+	funcs["genResponsePayload"] = g.genResponsePayload
+	funcs["genResponseTypeName"] = g.genResponseTypeName
+
+	return funcs
+}
@@ -0,0 +1,87 @@
+package codegen
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeneratorConcurrent(t *testing.T) {
+	loadSpec := func(t *testing.T) *openapi3.T {
+		loader := openapi3.NewLoader()
+		loader.IsExternalRefsAllowed = true
+		swagger, err := loader.LoadFromData([]byte(testOpenAPIDefinition))
+		require.NoError(t, err)
+		return swagger
+	}
+
+	configs := []Configuration{
+		{
+			PackageName: "first",
+			Generate: GenerateOptions{
+				EchoServer: true,
+				Client:     true,
+				Models:     true,
+			},
+		},
+		{
+			PackageName: "second",
+			Generate: GenerateOptions{
+				ChiServer: true,
+				Client:    true,
+				Models:    true,
+			},
+			OutputOptions: OutputOptions{
+				ResponseTypeSuffix: "Result",
+				NameNormalizer:     string(NameNormalizerFunctionToCamelCaseWithInitialisms),
+			},
+			Compatibility: CompatibilityOptions{
+				AlwaysPrefixEnumValues: true,
+			},
+		},
+	}
+
+	// Generate the expected code for each Configuration on its own first.
+	expected := make([]string, len(configs))
+	for i, opts := range configs {
+		g, err := NewGenerator(loadSpec(t), opts)
+		require.NoError(t, err)
+		expected[i], err = g.Generate()
+		require.NoError(t, err)
+	}
+	assert.Contains(t, expected[0], "type GetTestByNameResponse struct {")
+	assert.Contains(t, expected[1], "type GetTestByNameResult struct {")
+
+	const runs = 4
+	results := make([]string, runs*len(configs))
+	errs := make([]error, runs*len(configs))
+
+	var wg sync.WaitGroup
+	for i := range results {
+		opts := configs[i%len(configs)]
+		spec := loadSpec(t)
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], errs[i] = Generate(spec, opts)
+		}(i)
+	}
+	wg.Wait()
+
+	for i := range results {
+		require.NoError(t, errs[i])
+		assert.Equal(t, expected[i%len(configs)], results[i])
+	}
+}
+
+func TestNewGeneratorInvalidNameNormalizer(t *testing.T) {
+	_, err := NewGenerator(&openapi3.T{}, Configuration{
+		OutputOptions: OutputOptions{
+			NameNormalizer: "ToSomethingElse",
+		},
+	})
+	assert.ErrorContains(t, err, "could not be found among options")
+}
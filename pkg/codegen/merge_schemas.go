@@ -10,20 +10,28 @@ import (
 
 // MergeSchemas merges all the fields in the schemas supplied into one giant schema.
 // The idea is that we merge all fields together into one schema.
+//
+// It uses the default Configuration; see Generator.MergeSchemas.
 func MergeSchemas(allOf []*openapi3.SchemaRef, path []string) (Schema, error) {
+	return defaultGenerator.MergeSchemas(allOf, path)
+}
+
+// MergeSchemas merges all the fields in the schemas supplied into one giant
+// schema, as the package level MergeSchemas.
+func (g *Generator) MergeSchemas(allOf []*openapi3.SchemaRef, path []string) (Schema, error) {
 	// If someone asked for the old way, for backward compatibility, return the
 	// old style result.
-	if globalState.options.Compatibility.OldMergeSchemas {
-		return mergeSchemasV1(allOf, path)
+	if g.opts.Compatibility.OldMergeSchemas {
+		return g.mergeSchemasV1(allOf, path)
 	}
-	return mergeSchemas(allOf, path)
+	return g.mergeSchemas(allOf, path)
 }
 
-func mergeSchemas(allOf []*openapi3.SchemaRef, path []string) (Schema, error) {
+func (g *Generator) mergeSchemas(allOf []*openapi3.SchemaRef, path []string) (Schema, error) {
 	n := len(allOf)
 
 	if n == 1 {
-		return GenerateGoSchema(allOf[0], path)
+		return g.GenerateGoSchema(allOf[0], path)
 	}
 
 	schema, err := valueWithPropagatedRef(allOf[0])
@@ -42,7 +50,7 @@ func mergeSchemas(allOf []*openapi3.SchemaRef, path []string) (Schema, error) {
 			return Schema{}, fmt.Errorf("error merging schemas for AllOf: %w", err)
 		}
 	}
-	return GenerateGoSchema(openapi3.NewSchemaRef("", &schema), path)
+	return g.GenerateGoSchema(openapi3.NewSchemaRef("", &schema), path)
 }
 
 // valueWithPropagatedRef returns a copy of ref schema with its Properties refs
@@ -0,0 +1,244 @@
+package codegen
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+// MockDefinition describes the fake generated for one of the interfaces of the
+// generated code, which records the calls made to it, and hands each of them
+// to a function field set by the test using it.
+type MockDefinition struct {
+	TypeName  string       // The name of the mock, such as MockClient
+	Interface string       // The name of the interface it implements
+	Methods   []MockMethod // The methods of the interface
+}
+
+// MockMethod is one of the methods of the interface of a MockDefinition.
+type MockMethod struct {
+	Name    string
+	Params  []MockParam
+	Results []string
+	// Variadic is whether the last of the Params is variadic, in which case
+	// its Type is that of the slice it's received as
+	Variadic bool
+}
+
+// MockParam is one of the parameters of a MockMethod.
+type MockParam struct {
+	Name string
+	Type string
+}
+
+// FieldName is the name of the field of the call the parameter is captured in.
+func (p MockParam) FieldName() string {
+	return UppercaseFirstCharacter(p.Name)
+}
+
+// ParamList returns the parameters of the method, as declared in its
+// signature, such as "ctx context.Context, reqEditors ...RequestEditorFn".
+func (m MockMethod) ParamList() string {
+	parts := make([]string, len(m.Params))
+	for i, p := range m.Params {
+		if m.Variadic && i == len(m.Params)-1 {
+			parts[i] = fmt.Sprintf("%s ...%s", p.Name, strings.TrimPrefix(p.Type, "[]"))
+			continue
+		}
+		parts[i] = fmt.Sprintf("%s %s", p.Name, p.Type)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// ArgList returns the parameters of the method as the arguments of a call to
+// a function with the same signature.
+func (m MockMethod) ArgList() string {
+	parts := make([]string, len(m.Params))
+	for i, p := range m.Params {
+		parts[i] = p.Name
+	}
+	if m.Variadic && len(parts) > 0 {
+		parts[len(parts)-1] += "..."
+	}
+	return strings.Join(parts, ", ")
+}
+
+// ResultList returns the results of the method, as declared in its signature.
+func (m MockMethod) ResultList() string {
+	switch len(m.Results) {
+	case 0:
+		return ""
+	case 1:
+		return m.Results[0]
+	default:
+		return "(" + strings.Join(m.Results, ", ") + ")"
+	}
+}
+
+// MockDefinitions returns the mocks of each of the interfaces generated for the
+// operations, as enabled in the Generator's Configuration: ClientInterface and
+// ClientWithResponsesInterface for the client, ServerInterface for the server,
+// and StrictServerInterface for the strict server.
+func (g *Generator) MockDefinitions(ops []OperationDefinition) []MockDefinition {
+	var mocks []MockDefinition
+	if g.opts.Generate.Client {
+		httpResponse := func(OperationDefinition) string {
+			return "*http.Response"
+		}
+		parsedResponse := func(op OperationDefinition) string {
+			return "*" + g.genResponseTypeName(op.OperationId)
+		}
+		mocks = append(mocks,
+			MockDefinition{TypeName: "MockClient", Interface: "ClientInterface", Methods: clientMockMethods(ops, "", httpResponse)},
+			MockDefinition{TypeName: "MockClientWithResponses", Interface: "ClientWithResponsesInterface", Methods: clientMockMethods(ops, "WithResponse", parsedResponse)},
+		)
+	}
+
+	if params, results := g.serverMockSignature(); params != nil {
+		var methods []MockMethod
+		for _, op := range ops {
+			method := MockMethod{Name: op.OperationId, Results: results}
+			method.Params = append(append([]MockParam{}, params...), pathMockParams(op)...)
+			if op.RequiresParamObject() {
+				method.Params = append(method.Params, MockParam{Name: "params", Type: op.OperationId + "Params"})
+			}
+			methods = append(methods, method)
+		}
+		mocks = append(mocks, MockDefinition{TypeName: "MockServer", Interface: "ServerInterface", Methods: methods})
+	}
+
+	if g.opts.Generate.Strict {
+		var methods []MockMethod
+		for _, op := range ops {
+			name := UppercaseFirstCharacter(op.OperationId)
+			methods = append(methods, MockMethod{
+				Name: op.OperationId,
+				Params: []MockParam{
+					{Name: "ctx", Type: "context.Context"},
+					{Name: "request", Type: name + "RequestObject"},
+				},
+				Results: []string{name + "ResponseObject", "error"},
+			})
+		}
+		mocks = append(mocks, MockDefinition{TypeName: "MockStrictServer", Interface: "StrictServerInterface", Methods: methods})
+	}
+	return mocks
+}
+
+// clientMockMethods returns the methods of the client interfaces for the
+// operations, whose names end with the suffix, and which return the result of
+// the operation along with an error.
+func clientMockMethods(ops []OperationDefinition, suffix string, result func(OperationDefinition) string) []MockMethod {
+	var methods []MockMethod
+	for _, op := range ops {
+		params := append([]MockParam{{Name: "ctx", Type: "context.Context"}}, pathMockParams(op)...)
+		if op.RequiresParamObject() {
+			params = append(params, MockParam{Name: "params", Type: "*" + op.OperationId + "Params"})
+		}
+
+		newMethod := func(name string, extra ...MockParam) MockMethod {
+			methodParams := append(append(append([]MockParam{}, params...), extra...), MockParam{Name: "reqEditors", Type: "[]RequestEditorFn"})
+			return MockMethod{
+				Name:     name + suffix,
+				Params:   methodParams,
+				Results:  []string{result(op), "error"},
+				Variadic: true,
+			}
+		}
+
+		if !op.HasBody() {
+			methods = append(methods, newMethod(op.OperationId))
+			continue
+		}
+		methods = append(methods, newMethod(op.OperationId+"WithBody",
+			MockParam{Name: "contentType", Type: "string"},
+			MockParam{Name: "body", Type: "io.Reader"},
+		))
+		for _, body := range op.Bodies {
+			if !body.IsSupportedByClient() {
+				continue
+			}
+			methods = append(methods, newMethod(op.OperationId+body.Suffix(),
+				MockParam{Name: "body", Type: op.OperationId + body.NameTag + "RequestBody"},
+			))
+		}
+	}
+	return methods
+}
+
+// pathMockParams returns the path parameters of the operation, as they're
+// passed to the methods of the generated interfaces.
+func pathMockParams(op OperationDefinition) []MockParam {
+	params := make([]MockParam, len(op.PathParams))
+	for i, p := range op.PathParams {
+		params[i] = MockParam{Name: p.GoVariableName(), Type: p.TypeDef()}
+	}
+	return params
+}
+
+// serverMockSignature returns the parameters which each method of the
+// ServerInterface of the generated server starts with, and its results, or
+// nil if no server is generated.
+func (g *Generator) serverMockSignature() ([]MockParam, []string) {
+	gen := g.opts.Generate
+	switch {
+	case gen.ChiServer, gen.GorillaServer, gen.StdHTTPServer, gen.HttprouterServer:
+		return []MockParam{{Name: "w", Type: "http.ResponseWriter"}, {Name: "r", Type: "*http.Request"}}, nil
+	case gen.EchoServer:
+		return []MockParam{{Name: "ctx", Type: "echo.Context"}}, []string{"error"}
+	case gen.FasthttpServer:
+		return []MockParam{{Name: "ctx", Type: "*fasthttp.RequestCtx"}}, nil
+	case gen.FiberServer:
+		return []MockParam{{Name: "c", Type: "*fiber.Ctx"}}, []string{"error"}
+	case gen.FiberV3Server:
+		return []MockParam{{Name: "c", Type: "fiber.Ctx"}}, []string{"error"}
+	case gen.GinServer:
+		return []MockParam{{Name: "c", Type: "*gin.Context"}}, nil
+	case gen.HertzServer:
+		return []MockParam{{Name: "ctx", Type: "context.Context"}, {Name: "c", Type: "*app.RequestContext"}}, nil
+	case gen.IrisServer:
+		return []MockParam{{Name: "ctx", Type: "iris.Context"}}, nil
+	}
+	return nil, nil
+}
+
+// securityMockMethods returns the methods of the SecurityHandler, which the
+// server interfaces embed when it's generated.
+func (g *Generator) securityMockMethods() ([]MockMethod, error) {
+	schemes, err := g.SecuritySchemeDefinitions(g.spec)
+	if err != nil {
+		return nil, err
+	}
+	var methods []MockMethod
+	for _, s := range schemes {
+		method := MockMethod{
+			Name:    "Handle" + s.GoName,
+			Params:  []MockParam{{Name: "ctx", Type: "context.Context"}},
+			Results: []string{"context.Context", "error"},
+		}
+		for _, name := range strings.Split(s.CredentialArgs(), ", ") {
+			method.Params = append(method.Params, MockParam{Name: name, Type: "string"})
+		}
+		method.Params = append(method.Params, MockParam{Name: "scopes", Type: "[]string"})
+		methods = append(methods, method)
+	}
+	return methods, nil
+}
+
+// GenerateMocks generates the mocks of the interfaces generated for the
+// operations.
+func (g *Generator) GenerateMocks(t *template.Template, ops []OperationDefinition) (string, error) {
+	mocks := g.MockDefinitions(ops)
+	if g.opts.Generate.SecurityHandler {
+		methods, err := g.securityMockMethods()
+		if err != nil {
+			return "", err
+		}
+		for i, mock := range mocks {
+			if mock.Interface == "ServerInterface" || mock.Interface == "StrictServerInterface" {
+				mocks[i].Methods = append(mock.Methods, methods...)
+			}
+		}
+	}
+	return GenerateTemplates([]string{"mocks.tmpl"}, t, mocks)
+}
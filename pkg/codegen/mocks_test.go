@@ -0,0 +1,118 @@
+package codegen
+
+import (
+	"go/format"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const mocksSpec = `
+openapi: "3.0.0"
+info:
+  version: 1.0.0
+  title: Mocks
+paths:
+  /pets/{id}:
+    put:
+      operationId: updatePet
+      parameters:
+        - name: id
+          in: path
+          required: true
+          schema:
+            type: string
+        - name: dryRun
+          in: query
+          schema:
+            type: boolean
+      requestBody:
+        content:
+          application/json:
+            schema:
+              type: object
+      responses:
+        '204':
+          description: Updated
+`
+
+func TestMockDefinitions(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(mocksSpec))
+	require.NoError(t, err)
+
+	opts := Configuration{
+		PackageName: "api",
+		Generate: GenerateOptions{
+			EchoServer: true,
+			Strict:     true,
+			Client:     true,
+		},
+	}
+	g, err := NewGenerator(swagger, opts)
+	require.NoError(t, err)
+	ops, err := g.OperationDefinitions(swagger, false)
+	require.NoError(t, err)
+
+	mocks := g.MockDefinitions(ops)
+	require.Len(t, mocks, 4)
+
+	assert.Equal(t, "MockClient", mocks[0].TypeName)
+	require.Len(t, mocks[0].Methods, 2)
+	withBody := mocks[0].Methods[0]
+	assert.Equal(t, "UpdatePetWithBody", withBody.Name)
+	assert.Equal(t, "ctx context.Context, id string, params *UpdatePetParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn", withBody.ParamList())
+	assert.Equal(t, "ctx, id, params, contentType, body, reqEditors...", withBody.ArgList())
+	assert.Equal(t, "(*http.Response, error)", withBody.ResultList())
+	assert.Equal(t, "UpdatePet", mocks[0].Methods[1].Name)
+
+	assert.Equal(t, "MockClientWithResponses", mocks[1].TypeName)
+	require.Len(t, mocks[1].Methods, 2)
+	assert.Equal(t, "UpdatePetWithBodyWithResponse", mocks[1].Methods[0].Name)
+	assert.Equal(t, "(*UpdatePetResponse, error)", mocks[1].Methods[0].ResultList())
+
+	assert.Equal(t, "MockServer", mocks[2].TypeName)
+	require.Len(t, mocks[2].Methods, 1)
+	assert.Equal(t, "ctx echo.Context, id string, params UpdatePetParams", mocks[2].Methods[0].ParamList())
+	assert.Equal(t, "error", mocks[2].Methods[0].ResultList())
+
+	assert.Equal(t, "MockStrictServer", mocks[3].TypeName)
+	require.Len(t, mocks[3].Methods, 1)
+	assert.Equal(t, "ctx context.Context, request UpdatePetRequestObject", mocks[3].Methods[0].ParamList())
+	assert.Equal(t, "(UpdatePetResponseObject, error)", mocks[3].Methods[0].ResultList())
+}
+
+func TestGenerateMocks(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(mocksSpec))
+	require.NoError(t, err)
+
+	opts := Configuration{
+		PackageName: "api",
+		Generate: GenerateOptions{
+			Models:    true,
+			ChiServer: true,
+			Client:    true,
+			Mocks:     true,
+		},
+	}
+	files, err := GenerateFiles(swagger, opts)
+	require.NoError(t, err)
+
+	code := files[MocksFileName]
+	_, err = format.Source([]byte(code))
+	require.NoError(t, err)
+
+	assert.Contains(t, code, "var _ ClientInterface = (*MockClient)(nil)")
+	assert.Contains(t, code, "var _ ServerInterface = (*MockServer)(nil)")
+	assert.NotContains(t, code, "MockStrictServer")
+	assert.Contains(t, code, "UpdatePetFunc func(w http.ResponseWriter, r *http.Request, id string, params UpdatePetParams)")
+	assert.Contains(t, code, "\tm.UpdatePetFunc(w, r, id, params)\n")
+	assert.Contains(t, code, "\treturn m.UpdatePetWithBodyFunc(ctx, id, params, contentType, body, reqEditors...)\n")
+	assert.Contains(t, code, "func (m *MockClient) UpdatePetCalls() []MockClientUpdatePetCall {")
+}
+
+func TestMocksRequireInterface(t *testing.T) {
+	problems := GenerateOptions{Models: true, Mocks: true}.Validate()
+	assert.Equal(t, map[string]string{"mocks": "requires a client or a server to be generated"}, problems)
+}
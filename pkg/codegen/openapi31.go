@@ -0,0 +1,374 @@
+package codegen
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+const (
+	// keywordDefs holds schemas which are defined for reuse within a schema
+	keywordDefs = "$defs"
+	// keywordConst restricts a value to a single constant
+	keywordConst = "const"
+	// keywordExamples holds a list of example values
+	keywordExamples = "examples"
+)
+
+// IsOpenAPI31 returns whether spec is an OpenAPI 3.1 specification.
+func IsOpenAPI31(spec *openapi3.T) bool {
+	return strings.HasPrefix(spec.OpenAPI, "3.1.")
+}
+
+// normalizeOpenAPI31 rewrites the parts of an OpenAPI 3.1 spec which
+// kin-openapi doesn't model, and which are left in the extensions of the
+// schemas, into their OpenAPI 3.0 equivalents, so the rest of the generator
+// can handle them in the same way. That is:
+//
+//   - type arrays which include "null" become nullable schemas, without null
+//     among their enum values
+//   - `const` becomes a single value `enum`
+//   - the first of the `examples` becomes the `example`
+//   - schemas in `$defs` are moved into the component schemas, and references
+//     to them are updated to match
+//   - `webhooks` are decoded into path items, and the references within them
+//     resolved, see specWebhooks
+//   - the `itemSchema` of streamed media types is decoded, see
+//     decodeStreamItemSchemas
+//
+// Specs for other versions of OpenAPI are left untouched, and normalizing a
+// spec more than once has no further effect.
+func normalizeOpenAPI31(spec *openapi3.T) error {
+	if !IsOpenAPI31(spec) {
+		return nil
+	}
+
+	if err := decodeWebhooks(spec); err != nil {
+		return err
+	}
+	if err := decodeStreamItemSchemas(spec); err != nil {
+		return err
+	}
+
+	movedDefs, err := moveDefsToComponents(spec)
+	if err != nil {
+		return err
+	}
+
+	visited := map[*openapi3.Schema]bool{}
+	var walkErr error
+	doFn := func(w RefWrapper) (bool, error) {
+		sref, ok := w.SourceRef.(*openapi3.SchemaRef)
+		if !ok {
+			if err := resolveComponentRef(spec, w.SourceRef); err != nil {
+				if walkErr == nil {
+					walkErr = err
+				}
+				return false, nil
+			}
+			return true, nil
+		}
+		if err := updateDefRef(spec, sref, movedDefs); err != nil {
+			if walkErr == nil {
+				walkErr = err
+			}
+			return false, nil
+		}
+		if sref.Value == nil || visited[sref.Value] {
+			return false, nil
+		}
+		visited[sref.Value] = true
+		normalizeOpenAPI31Schema(sref.Value)
+		return true, nil
+	}
+
+	_ = walkSwagger(spec, doFn)
+	if spec.Paths == nil && len(specWebhooks(spec)) == 0 {
+		_ = walkComponents(spec.Components, doFn)
+	}
+	return walkErr
+}
+
+// normalizeOpenAPI31Schema rewrites the OpenAPI 3.1 keywords of a single
+// schema, without the schemas nested within it.
+func normalizeOpenAPI31Schema(schema *openapi3.Schema) {
+	if types := schema.Type.Slice(); len(types) > 1 {
+		var nonNull openapi3.Types
+		for _, t := range types {
+			if t == "null" {
+				schema.Nullable = true
+				continue
+			}
+			nonNull = append(nonNull, t)
+		}
+		schema.Type = &nonNull
+	}
+
+	if schema.Nullable {
+		// A nullable enum lists null as one of its values, which is
+		// represented by the nullable type instead.
+		var enum []interface{}
+		for _, value := range schema.Enum {
+			if value != nil {
+				enum = append(enum, value)
+			}
+		}
+		if len(enum) != len(schema.Enum) {
+			schema.Enum = enum
+		}
+	}
+
+	if value, ok := schema.Extensions[keywordConst]; ok {
+		delete(schema.Extensions, keywordConst)
+		if t := constType(value); t != "" {
+			if schema.Enum == nil {
+				schema.Enum = []interface{}{value}
+			}
+			if schema.Type.Slice() == nil {
+				schema.Type = &openapi3.Types{t}
+			}
+		}
+	}
+
+	if examples, ok := schema.Extensions[keywordExamples]; ok {
+		delete(schema.Extensions, keywordExamples)
+		if examples, ok := examples.([]interface{}); ok && len(examples) > 0 && schema.Example == nil {
+			schema.Example = examples[0]
+		}
+	}
+
+	if len(schema.Extensions) == 0 {
+		schema.Extensions = nil
+	}
+}
+
+// constType returns the OpenAPI type of a `const` value, or an empty string if
+// it isn't a primitive, and so can't be represented by an enum.
+func constType(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	case float64:
+		if v == math.Trunc(v) {
+			return "integer"
+		}
+		return "number"
+	case int, int64, uint64:
+		return "integer"
+	default:
+		return ""
+	}
+}
+
+// movedDef describes a schema in `$defs` which was moved into the component
+// schemas.
+type movedDef struct {
+	// ref is the reference to the schema in the component schemas
+	ref string
+	// schema is the moved schema
+	schema *openapi3.Schema
+}
+
+// moveDefsToComponents moves the schemas in the `$defs` of the component
+// schemas, and of the schemas nested within them, into the component schemas.
+// They keep their names, unless that clashes with an existing component, in
+// which case they're prefixed with the name of the schema they were defined
+// in. The result maps the old references to the moved schemas to where they
+// are now.
+func moveDefsToComponents(spec *openapi3.T) (map[string]movedDef, error) {
+	if spec.Components == nil {
+		return nil, nil
+	}
+
+	// Prefer the schemas which kin-openapi resolved references to $defs to,
+	// so that all the references share the same schema.
+	resolved := map[string]*openapi3.Schema{}
+	findRefs := func(w RefWrapper) (bool, error) {
+		sref, ok := w.SourceRef.(*openapi3.SchemaRef)
+		if !ok {
+			return true, nil
+		}
+		if strings.Contains(sref.Ref, "/"+keywordDefs+"/") && sref.Value != nil && resolved[sref.Ref] == nil {
+			resolved[sref.Ref] = sref.Value
+		}
+		return sref.Ref == "", nil
+	}
+	_ = walkSwagger(spec, findRefs)
+	if spec.Paths == nil && len(specWebhooks(spec)) == 0 {
+		_ = walkComponents(spec.Components, findRefs)
+	}
+
+	type container struct {
+		name    string
+		pointer string
+		schema  *openapi3.Schema
+	}
+	var queue []container
+	for _, name := range SortedSchemaKeys(spec.Components.Schemas) {
+		sref := spec.Components.Schemas[name]
+		if sref == nil || sref.Ref != "" || sref.Value == nil {
+			continue
+		}
+		queue = append(queue, container{
+			name:    name,
+			pointer: "#/components/schemas/" + escapeJSONPointerToken(name),
+			schema:  sref.Value,
+		})
+	}
+
+	moved := map[string]movedDef{}
+	for len(queue) > 0 {
+		c := queue[0]
+		queue = queue[1:]
+
+		var defs []container
+		var err error
+		visitNestedSchemas(c.schema, c.pointer, func(schema *openapi3.Schema, pointer string) {
+			rawDefs, ok := schema.Extensions[keywordDefs]
+			if !ok || err != nil {
+				return
+			}
+			delete(schema.Extensions, keywordDefs)
+
+			defsMap, ok := rawDefs.(map[string]interface{})
+			if !ok {
+				err = fmt.Errorf("invalid %s in %s: expected an object, but got %T", keywordDefs, pointer, rawDefs)
+				return
+			}
+			for _, defName := range SortedMapKeys(defsMap) {
+				defPointer := pointer + "/" + keywordDefs + "/" + escapeJSONPointerToken(defName)
+				def := resolved[defPointer]
+				if def == nil {
+					def, err = decodeSchema(defsMap[defName])
+					if err != nil {
+						err = fmt.Errorf("invalid schema %s: %w", defPointer, err)
+						return
+					}
+				}
+				defs = append(defs, container{name: defName, pointer: defPointer, schema: def})
+			}
+		})
+		if err != nil {
+			return nil, err
+		}
+		sort.Slice(defs, func(i, j int) bool {
+			return defs[i].pointer < defs[j].pointer
+		})
+
+		for _, def := range defs {
+			name := def.name
+			if _, exists := spec.Components.Schemas[name]; exists {
+				name = c.name + def.name
+			}
+			if _, exists := spec.Components.Schemas[name]; exists {
+				return nil, fmt.Errorf("can't move schema %s into the component schemas, as %q and %q already exist", def.pointer, def.name, name)
+			}
+
+			spec.Components.Schemas[name] = openapi3.NewSchemaRef("", def.schema)
+			moved[def.pointer] = movedDef{
+				ref:    "#/components/schemas/" + escapeJSONPointerToken(name),
+				schema: def.schema,
+			}
+			// The moved schema may have $defs of its own, which are
+			// still referred to relative to where it was defined.
+			queue = append(queue, container{name: name, pointer: def.pointer, schema: def.schema})
+		}
+	}
+	return moved, nil
+}
+
+// updateDefRef updates a reference to a schema which was moved out of `$defs`,
+// and resolves any references within the moved schemas which kin-openapi
+// didn't resolve.
+func updateDefRef(spec *openapi3.T, sref *openapi3.SchemaRef, moved map[string]movedDef) error {
+	if sref.Ref == "" {
+		return nil
+	}
+
+	if def, ok := moved[sref.Ref]; ok {
+		sref.Ref = def.ref
+		if sref.Value == nil {
+			sref.Value = def.schema
+		}
+	} else {
+		// The reference may be to a schema nested within a moved schema, in
+		// which case the longest matching pointer is the one it's within.
+		var within string
+		for pointer := range moved {
+			if strings.HasPrefix(sref.Ref, pointer+"/") && len(pointer) > len(within) {
+				within = pointer
+			}
+		}
+		if within != "" {
+			sref.Ref = moved[within].ref + strings.TrimPrefix(sref.Ref, within)
+		}
+	}
+
+	if sref.Value != nil {
+		return nil
+	}
+	name, ok := strings.CutPrefix(sref.Ref, "#/components/schemas/")
+	if ok {
+		if component := spec.Components.Schemas[unescapeJSONPointerToken(name)]; component != nil && component.Value != nil {
+			sref.Value = component.Value
+			return nil
+		}
+	}
+	return fmt.Errorf("unresolved reference %s", sref.Ref)
+}
+
+// visitNestedSchemas calls fn for schema, and each of the inline schemas
+// nested within it, along with their JSON pointers. It doesn't follow
+// references.
+func visitNestedSchemas(schema *openapi3.Schema, pointer string, fn func(*openapi3.Schema, string)) {
+	if schema == nil {
+		return
+	}
+	fn(schema, pointer)
+
+	visit := func(sref *openapi3.SchemaRef, pointer string) {
+		if sref != nil && sref.Ref == "" {
+			visitNestedSchemas(sref.Value, pointer, fn)
+		}
+	}
+	for name, property := range schema.Properties {
+		visit(property, pointer+"/properties/"+escapeJSONPointerToken(name))
+	}
+	visit(schema.Items, pointer+"/items")
+	visit(schema.AdditionalProperties.Schema, pointer+"/additionalProperties")
+	visit(schema.Not, pointer+"/not")
+	for i, sref := range schema.AllOf {
+		visit(sref, fmt.Sprintf("%s/allOf/%d", pointer, i))
+	}
+	for i, sref := range schema.AnyOf {
+		visit(sref, fmt.Sprintf("%s/anyOf/%d", pointer, i))
+	}
+	for i, sref := range schema.OneOf {
+		visit(sref, fmt.Sprintf("%s/oneOf/%d", pointer, i))
+	}
+}
+
+// decodeSchema decodes a schema which kin-openapi left undecoded. Any
+// references within it are left unresolved.
+func decodeSchema(raw interface{}) (*openapi3.Schema, error) {
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return nil, err
+	}
+	schema := &openapi3.Schema{}
+	if err := schema.UnmarshalJSON(data); err != nil {
+		return nil, err
+	}
+	return schema, nil
+}
+
+// unescapeJSONPointerToken reverses escapeJSONPointerToken.
+func unescapeJSONPointerToken(token string) string {
+	return strings.NewReplacer("~1", "/", "~0", "~").Replace(token)
+}
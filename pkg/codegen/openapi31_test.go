@@ -0,0 +1,102 @@
+package codegen
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testOpenAPI31Definition = `
+openapi: "3.1.0"
+info:
+  title: OpenAPI 3.1
+  version: "1.0"
+paths:
+  /owner:
+    get:
+      responses:
+        "200":
+          description: The owner
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/Pet/$defs/Owner'
+components:
+  schemas:
+    Pet:
+      type: object
+      properties:
+        name:
+          type: [string, "null"]
+          examples: [Rex]
+        kind:
+          const: pet
+        status:
+          type: [string, "null"]
+          enum: [available, null]
+        owner:
+          $ref: '#/components/schemas/Pet/$defs/Owner'
+        tag:
+          $ref: '#/components/schemas/Pet/$defs/Tag'
+      $defs:
+        Owner:
+          type: object
+          properties:
+            pet:
+              $ref: '#/components/schemas/Pet'
+        Tag:
+          type: string
+    Tag:
+      type: integer
+`
+
+func TestNormalizeOpenAPI31(t *testing.T) {
+	loader := openapi3.NewLoader()
+	spec, err := loader.LoadFromData([]byte(testOpenAPI31Definition))
+	require.NoError(t, err)
+
+	require.NoError(t, normalizeOpenAPI31(spec))
+	// Normalizing again has no further effect
+	require.NoError(t, normalizeOpenAPI31(spec))
+
+	pet := spec.Components.Schemas["Pet"].Value
+	assert.Nil(t, pet.Extensions)
+
+	name := pet.Properties["name"].Value
+	assert.Equal(t, &openapi3.Types{"string"}, name.Type)
+	assert.True(t, name.Nullable)
+	assert.Equal(t, "Rex", name.Example)
+
+	kind := pet.Properties["kind"].Value
+	assert.Equal(t, &openapi3.Types{"string"}, kind.Type)
+	assert.Equal(t, []interface{}{"pet"}, kind.Enum)
+
+	status := pet.Properties["status"].Value
+	assert.True(t, status.Nullable)
+	assert.Equal(t, []interface{}{"available"}, status.Enum)
+
+	// $defs are moved into the component schemas, prefixed by the name of
+	// the schema they're defined in when they clash
+	require.Contains(t, spec.Components.Schemas, "Owner")
+	require.Contains(t, spec.Components.Schemas, "PetTag")
+	assert.Equal(t, "#/components/schemas/Owner", pet.Properties["owner"].Ref)
+	assert.Equal(t, "#/components/schemas/PetTag", pet.Properties["tag"].Ref)
+
+	response := spec.Paths.Find("/owner").Get.Responses.Status(200).Value
+	assert.Equal(t, "#/components/schemas/Owner", response.Content.Get("application/json").Schema.Ref)
+
+	code, err := Generate(spec, Configuration{
+		PackageName: "api",
+		Generate:    GenerateOptions{Models: true},
+		OutputOptions: OutputOptions{
+			SkipPrune: true,
+		},
+	})
+	require.NoError(t, err)
+	assert.Contains(t, code, "type Owner struct {")
+	assert.Contains(t, code, "type PetTag = string")
+	assert.Contains(t, code, `PetKindPet PetKind = "pet"`)
+	assert.Contains(t, code, "Name   *string    `json:\"name\"`")
+}
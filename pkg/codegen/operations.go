@@ -17,6 +17,8 @@ import (
 	"bufio"
 	"bytes"
 	"fmt"
+	"mime"
+	"net/http"
 	"sort"
 	"strconv"
 	"strings"
@@ -34,6 +36,9 @@ type ParameterDefinition struct {
 	Required  bool   // Is this a required parameter?
 	Spec      *openapi3.Parameter
 	Schema    Schema
+
+	// generator is the Generator which created the parameter, if any
+	generator *Generator
 }
 
 // TypeDef is here as an adapter after a large refactoring so that I don't
@@ -132,7 +137,7 @@ func (pd ParameterDefinition) GoName() string {
 			goName = extGoFieldName
 		}
 	}
-	return SchemaNameToTypeName(goName)
+	return pd.generator.orDefault().SchemaNameToTypeName(goName)
 }
 
 func (pd ParameterDefinition) IndirectOptional() bool {
@@ -153,12 +158,20 @@ func (p ParameterDefinitions) FindByName(name string) *ParameterDefinition {
 // DescribeParameters walks the given parameters dictionary, and generates the above
 // descriptors into a flat list. This makes it a lot easier to traverse the
 // data in the template engine.
+//
+// It uses the default Configuration; see Generator.DescribeParameters.
 func DescribeParameters(params openapi3.Parameters, path []string) ([]ParameterDefinition, error) {
+	return defaultGenerator.DescribeParameters(params, path)
+}
+
+// DescribeParameters generates the descriptors for the given parameters, as
+// the package level DescribeParameters.
+func (g *Generator) DescribeParameters(params openapi3.Parameters, path []string) ([]ParameterDefinition, error) {
 	outParams := make([]ParameterDefinition, 0)
 	for _, paramOrRef := range params {
 		param := paramOrRef.Value
 
-		goType, err := paramToGoType(param, append(path, param.Name))
+		goType, err := g.paramToGoType(param, append(path, param.Name))
 		if err != nil {
 			return nil, fmt.Errorf("error generating type for param (%s): %s",
 				param.Name, err)
@@ -170,13 +183,14 @@ func DescribeParameters(params openapi3.Parameters, path []string) ([]ParameterD
 			Required:  param.Required,
 			Spec:      param,
 			Schema:    goType,
+			generator: g,
 		}
 
 		// If this is a reference to a predefined type, simply use the reference
 		// name as the type. $ref: "#/components/schemas/custom_type" becomes
 		// "CustomType".
 		if IsGoTypeReference(paramOrRef.Ref) {
-			goType, err := RefPathToGoType(paramOrRef.Ref)
+			goType, err := g.RefPathToGoType(paramOrRef.Ref)
 			if err != nil {
 				return nil, fmt.Errorf("error dereferencing (%s) for param (%s): %s",
 					paramOrRef.Ref, param.Name, err)
@@ -206,6 +220,16 @@ func DescribeSecurityDefinition(securityRequirements openapi3.SecurityRequiremen
 	return outDefs
 }
 
+// DescribeSecurityRequirements describes each of the alternative security
+// requirements, keeping them apart, unlike DescribeSecurityDefinition.
+func DescribeSecurityRequirements(securityRequirements openapi3.SecurityRequirements) [][]SecurityDefinition {
+	outReqs := make([][]SecurityDefinition, 0, len(securityRequirements))
+	for _, sr := range securityRequirements {
+		outReqs = append(outReqs, DescribeSecurityDefinition(openapi3.SecurityRequirements{sr}))
+	}
+	return outReqs
+}
+
 // OperationDefinition describes an Operation
 type OperationDefinition struct {
 	OperationId string // The operation_id description from Swagger, used to generate function names
@@ -222,7 +246,16 @@ type OperationDefinition struct {
 	Summary             string                  // Summary string from Swagger, used to generate a comment
 	Method              string                  // GET, POST, DELETE, etc.
 	Path                string                  // The Swagger path for the operation, like /resource/{id}
+	Idempotent          *bool                   // Whether the operation is idempotent, if declared by its x-idempotent extension
+	Pagination          *PaginationDefinition   // How the client iterates over the pages of the operation, if declared by its x-pagination extension
 	Spec                *openapi3.Operation
+
+	// SecurityRequirements are the alternative security requirements of the
+	// operation, any one of which must be met, by meeting each of its providers
+	SecurityRequirements [][]SecurityDefinition
+
+	// generator is the Generator which created the operation, if any
+	generator *Generator
 }
 
 // Params returns the list of all parameters except Path parameters. Path parameters
@@ -248,6 +281,18 @@ func (o *OperationDefinition) RequiresParamObject() bool {
 	return len(o.Params()) > 0
 }
 
+// HasParamDefaults returns whether any of the optional query, header or cookie
+// parameters of the operation have a default, which the server wrappers apply
+// when the parameter is missing, when defaults are generated.
+func (o *OperationDefinition) HasParamDefaults() bool {
+	for _, p := range o.Params() {
+		if !p.Required && p.Schema.OAPISchema != nil && p.Schema.OAPISchema.Default != nil {
+			return true
+		}
+	}
+	return false
+}
+
 // HasBody is called by the template engine to determine whether to generate body
 // marshaling code on the client. This is true for all body types, whether
 // we generate types for them.
@@ -255,6 +300,19 @@ func (o *OperationDefinition) HasBody() bool {
 	return o.Spec.RequestBody != nil
 }
 
+// IsIdempotent returns whether the operation is idempotent, as declared by its
+// x-idempotent extension, or otherwise as implied by its method.
+func (o *OperationDefinition) IsIdempotent() bool {
+	if o.Idempotent != nil {
+		return *o.Idempotent
+	}
+	switch strings.ToUpper(o.Method) {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
 // SummaryAsComment returns the Operations summary as a multi line comment
 func (o *OperationDefinition) SummaryAsComment() string {
 	if o.Summary == "" {
@@ -273,6 +331,7 @@ func (o *OperationDefinition) SummaryAsComment() string {
 // response object for automatic deserialization of responses in the generated
 // Client code. See "client-with-responses.tmpl".
 func (o *OperationDefinition) GetResponseTypeDefinitions() ([]ResponseTypeDefinition, error) {
+	g := o.generator.orDefault().withVariant(responseVariant)
 	var tds []ResponseTypeDefinition
 
 	if o.Spec == nil || o.Spec.Responses == nil {
@@ -297,7 +356,7 @@ func (o *OperationDefinition) GetResponseTypeDefinitions() ([]ResponseTypeDefini
 				contentType := responseRef.Value.Content[contentTypeName]
 				// We can only generate a type if we have a schema:
 				if contentType.Schema != nil {
-					responseSchema, err := GenerateGoSchema(contentType.Schema, []string{o.OperationId, responseName})
+					responseSchema, err := g.GenerateGoSchema(contentType.Schema, []string{o.OperationId, responseName})
 					if err != nil {
 						return nil, fmt.Errorf("Unable to determine Go type for %s.%s: %w", o.OperationId, contentTypeName, err)
 					}
@@ -307,36 +366,37 @@ func (o *OperationDefinition) GetResponseTypeDefinitions() ([]ResponseTypeDefini
 
 					// HAL+JSON:
 					case StringInArray(contentTypeName, contentTypesHalJSON):
-						typeName = fmt.Sprintf("HALJSON%s", nameNormalizer(responseName))
+						typeName = fmt.Sprintf("HALJSON%s", g.nameNormalizer(responseName))
 					case "application/json" == contentTypeName:
 						// if it's the standard application/json
-						typeName = fmt.Sprintf("JSON%s", nameNormalizer(responseName))
+						typeName = fmt.Sprintf("JSON%s", g.nameNormalizer(responseName))
 					// Vendored JSON
 					case StringInArray(contentTypeName, contentTypesJSON) || util.IsMediaTypeJson(contentTypeName):
-						baseTypeName := fmt.Sprintf("%s%s", nameNormalizer(contentTypeName), nameNormalizer(responseName))
+						baseTypeName := fmt.Sprintf("%s%s", g.nameNormalizer(contentTypeName), g.nameNormalizer(responseName))
 
 						typeName = strings.ReplaceAll(baseTypeName, "Json", "JSON")
 					// YAML:
 					case StringInArray(contentTypeName, contentTypesYAML):
-						typeName = fmt.Sprintf("YAML%s", nameNormalizer(responseName))
+						typeName = fmt.Sprintf("YAML%s", g.nameNormalizer(responseName))
 					// XML:
 					case StringInArray(contentTypeName, contentTypesXML):
-						typeName = fmt.Sprintf("XML%s", nameNormalizer(responseName))
+						typeName = fmt.Sprintf("XML%s", g.nameNormalizer(responseName))
 					default:
 						continue
 					}
 
 					td := ResponseTypeDefinition{
 						TypeDefinition: TypeDefinition{
-							TypeName: typeName,
-							Schema:   responseSchema,
+							TypeName:  typeName,
+							Schema:    responseSchema,
+							generator: g,
 						},
 						ResponseName:              responseName,
 						ContentTypeName:           contentTypeName,
 						AdditionalTypeDefinitions: responseSchema.GetAdditionalTypeDefs(),
 					}
 					if IsGoTypeReference(responseRef.Ref) {
-						refType, err := RefPathToGoType(responseRef.Ref)
+						refType, err := g.RefPathToGoType(responseRef.Ref)
 						if err != nil {
 							return nil, fmt.Errorf("error dereferencing response Ref: %w", err)
 						}
@@ -383,13 +443,17 @@ type RequestBodyDefinition struct {
 
 	// Contains encoding options for formdata
 	Encoding map[string]RequestBodyEncoding
+
+	// generator is the Generator which created the body, if any
+	generator *Generator
 }
 
 // TypeDef returns the Go type definition for a request body
 func (r RequestBodyDefinition) TypeDef(opID string) *TypeDefinition {
 	return &TypeDefinition{
-		TypeName: fmt.Sprintf("%s%sRequestBody", opID, r.NameTag),
-		Schema:   r.Schema,
+		TypeName:  fmt.Sprintf("%s%sRequestBody", opID, r.NameTag),
+		Schema:    r.Schema,
+		generator: r.generator,
 	}
 }
 
@@ -446,6 +510,9 @@ type ResponseDefinition struct {
 	Contents    []ResponseContentDefinition
 	Headers     []ResponseHeaderDefinition
 	Ref         string
+
+	// generator is the Generator which created the response, if any
+	generator *Generator
 }
 
 func (r ResponseDefinition) HasFixedStatusCode() bool {
@@ -454,7 +521,7 @@ func (r ResponseDefinition) HasFixedStatusCode() bool {
 }
 
 func (r ResponseDefinition) GoName() string {
-	return SchemaNameToTypeName(r.StatusCode)
+	return r.generator.orDefault().SchemaNameToTypeName(r.StatusCode)
 }
 
 func (r ResponseDefinition) IsRef() bool {
@@ -478,13 +545,21 @@ type ResponseContentDefinition struct {
 	// When we generate type names, we need a Tag for it, such as JSON, in
 	// which case we will produce "Response200JSONContent".
 	NameTag string
+
+	// ItemSchema is the schema of each item of a streamed content type, such
+	// as Server-Sent Events, if its items are typed. See IsStream.
+	ItemSchema *Schema
+
+	// generator is the Generator which created the content, if any
+	generator *Generator
 }
 
 // TypeDef returns the Go type definition for a request body
 func (r ResponseContentDefinition) TypeDef(opID string, statusCode int) *TypeDefinition {
 	return &TypeDefinition{
-		TypeName: fmt.Sprintf("%s%v%sResponse", opID, statusCode, r.NameTagOrContentType()),
-		Schema:   r.Schema,
+		TypeName:  fmt.Sprintf("%s%v%sResponse", opID, statusCode, r.NameTagOrContentType()),
+		Schema:    r.Schema,
+		generator: r.generator,
 	}
 }
 
@@ -492,6 +567,19 @@ func (r ResponseContentDefinition) IsSupported() bool {
 	return r.NameTag != ""
 }
 
+// IsStream returns whether the content is streamed as a sequence of typed
+// items, which are written and read one at a time.
+func (r ResponseContentDefinition) IsStream() bool {
+	return r.ItemSchema != nil
+}
+
+// IsEventStream returns whether the content is streamed as Server-Sent Events,
+// rather than newline-delimited JSON.
+func (r ResponseContentDefinition) IsEventStream() bool {
+	mediaType, _, _ := mime.ParseMediaType(r.ContentType)
+	return mediaType == mediaTypeEventStream
+}
+
 // HasFixedContentType returns true if content type has fixed content type, i.e. contains no "*" symbol
 func (r ResponseContentDefinition) HasFixedContentType() bool {
 	return !strings.Contains(r.ContentType, "*")
@@ -501,7 +589,7 @@ func (r ResponseContentDefinition) NameTagOrContentType() string {
 	if r.NameTag != "" {
 		return r.NameTag
 	}
-	return SchemaNameToTypeName(r.ContentType)
+	return r.generator.orDefault().SchemaNameToTypeName(r.ContentType)
 }
 
 // IsJSON returns whether this is a JSON media type, for instance:
@@ -531,7 +619,15 @@ func FilterParameterDefinitionByType(params []ParameterDefinition, in string) []
 }
 
 // OperationDefinitions returns all operations for a swagger definition.
+//
+// It uses the default Configuration; see Generator.OperationDefinitions.
 func OperationDefinitions(swagger *openapi3.T, initialismOverrides bool) ([]OperationDefinition, error) {
+	return defaultGenerator.OperationDefinitions(swagger, initialismOverrides)
+}
+
+// OperationDefinitions returns all operations for a swagger definition, using
+// the Generator's Configuration.
+func (g *Generator) OperationDefinitions(swagger *openapi3.T, initialismOverrides bool) ([]OperationDefinition, error) {
 	var operations []OperationDefinition
 
 	var toCamelCaseFunc func(string) string
@@ -549,7 +645,7 @@ func OperationDefinitions(swagger *openapi3.T, initialismOverrides bool) ([]Oper
 		pathItem := swagger.Paths.Value(requestPath)
 		// These are parameters defined for all methods on a given path. They
 		// are shared by all methods.
-		globalParams, err := DescribeParameters(pathItem.Parameters, nil)
+		globalParams, err := g.DescribeParameters(pathItem.Parameters, nil)
 		if err != nil {
 			return nil, fmt.Errorf("error describing global parameters for %s: %s",
 				requestPath, err)
@@ -564,99 +660,130 @@ func OperationDefinitions(swagger *openapi3.T, initialismOverrides bool) ([]Oper
 			}
 			// We rely on OperationID to generate function names, it's required
 			if op.OperationID == "" {
-				op.OperationID, err = generateDefaultOperationID(opName, requestPath, toCamelCaseFunc)
+				op.OperationID, err = g.generateDefaultOperationID(opName, requestPath, toCamelCaseFunc)
 				if err != nil {
 					return nil, fmt.Errorf("error generating default OperationID for %s/%s: %s",
 						opName, requestPath, err)
 				}
 			} else {
-				op.OperationID = nameNormalizer(op.OperationID)
+				op.OperationID = g.nameNormalizer(op.OperationID)
 			}
 			op.OperationID = typeNamePrefix(op.OperationID) + op.OperationID
 
-			// These are parameters defined for the specific path method that
-			// we're iterating over.
-			localParams, err := DescribeParameters(op.Parameters, []string{op.OperationID + "Params"})
-			if err != nil {
-				return nil, fmt.Errorf("error describing global parameters for %s/%s: %s",
-					opName, requestPath, err)
-			}
-			// All the parameters required by a handler are the union of the
-			// global parameters and the local parameters.
-			allParams, err := CombineOperationParameters(globalParams, localParams)
+			opDef, err := g.describeOperation(swagger, pathItem, globalParams, opName, op, requestPath, true)
 			if err != nil {
 				return nil, err
 			}
+			operations = append(operations, *opDef)
+		}
+	}
+	return operations, nil
+}
 
-			ensureExternalRefsInParameterDefinitions(&allParams, pathItem.Ref)
-
-			// Order the path parameters to match the order as specified in
-			// the path, not in the swagger spec, and validate that the parameter
-			// names match, as downstream code depends on that.
-			pathParams := FilterParameterDefinitionByType(allParams, "path")
-			pathParams, err = SortParamsByPath(requestPath, pathParams)
-			if err != nil {
-				return nil, err
-			}
+// describeOperation describes a single operation of a path item, whose
+// OperationID has already been set. globalParams are the parameters shared by
+// all the operations of the path item. Webhooks and callbacks aren't served at
+// a path of the spec, in which case servedAtPath is false, requestPath is
+// only used to describe them, and they have no path parameters.
+func (g *Generator) describeOperation(swagger *openapi3.T, pathItem *openapi3.PathItem, globalParams []ParameterDefinition, opName string, op *openapi3.Operation, requestPath string, servedAtPath bool) (*OperationDefinition, error) {
+	// These are parameters defined for the specific path method that
+	// we're iterating over.
+	localParams, err := g.DescribeParameters(op.Parameters, []string{op.OperationID + "Params"})
+	if err != nil {
+		return nil, fmt.Errorf("error describing global parameters for %s/%s: %s",
+			opName, requestPath, err)
+	}
+	// All the parameters required by a handler are the union of the
+	// global parameters and the local parameters.
+	allParams, err := CombineOperationParameters(globalParams, localParams)
+	if err != nil {
+		return nil, err
+	}
 
-			bodyDefinitions, typeDefinitions, err := GenerateBodyDefinitions(op.OperationID, op.RequestBody)
-			if err != nil {
-				return nil, fmt.Errorf("error generating body definitions: %w", err)
-			}
+	g.ensureExternalRefsInParameterDefinitions(&allParams, pathItem.Ref)
 
-			ensureExternalRefsInRequestBodyDefinitions(&bodyDefinitions, pathItem.Ref)
+	// Order the path parameters to match the order as specified in
+	// the path, not in the swagger spec, and validate that the parameter
+	// names match, as downstream code depends on that.
+	var pathParams []ParameterDefinition
+	if servedAtPath {
+		pathParams = FilterParameterDefinitionByType(allParams, "path")
+		pathParams, err = SortParamsByPath(requestPath, pathParams)
+		if err != nil {
+			return nil, err
+		}
+	}
 
-			responseDefinitions, err := GenerateResponseDefinitions(op.OperationID, op.Responses.Map())
-			if err != nil {
-				return nil, fmt.Errorf("error generating response definitions: %w", err)
-			}
+	bodyDefinitions, typeDefinitions, err := g.GenerateBodyDefinitions(op.OperationID, op.RequestBody)
+	if err != nil {
+		return nil, fmt.Errorf("error generating body definitions: %w", err)
+	}
 
-			ensureExternalRefsInResponseDefinitions(&responseDefinitions, pathItem.Ref)
-
-			opDef := OperationDefinition{
-				PathParams:   pathParams,
-				HeaderParams: FilterParameterDefinitionByType(allParams, "header"),
-				QueryParams:  FilterParameterDefinitionByType(allParams, "query"),
-				CookieParams: FilterParameterDefinitionByType(allParams, "cookie"),
-				OperationId:  nameNormalizer(op.OperationID),
-				// Replace newlines in summary.
-				Summary:         op.Summary,
-				Method:          opName,
-				Path:            requestPath,
-				Spec:            op,
-				Bodies:          bodyDefinitions,
-				Responses:       responseDefinitions,
-				TypeDefinitions: typeDefinitions,
-			}
+	g.ensureExternalRefsInRequestBodyDefinitions(&bodyDefinitions, pathItem.Ref)
 
-			// check for overrides of SecurityDefinitions.
-			// See: "Step 2. Applying security:" from the spec:
-			// https://swagger.io/docs/specification/authentication/
-			if op.Security != nil {
-				opDef.SecurityDefinitions = DescribeSecurityDefinition(*op.Security)
-			} else {
-				// use global securityDefinitions
-				// globalSecurityDefinitions contains the top-level securityDefinitions.
-				// They are the default securityPermissions which are injected into each
-				// path, except for the case where a path explicitly overrides them.
-				opDef.SecurityDefinitions = DescribeSecurityDefinition(swagger.Security)
+	responseDefinitions, err := g.GenerateResponseDefinitions(op.OperationID, op.Responses.Map())
+	if err != nil {
+		return nil, fmt.Errorf("error generating response definitions: %w", err)
+	}
 
-			}
+	g.ensureExternalRefsInResponseDefinitions(&responseDefinitions, pathItem.Ref)
+
+	opDef := OperationDefinition{
+		PathParams:   pathParams,
+		HeaderParams: FilterParameterDefinitionByType(allParams, "header"),
+		QueryParams:  FilterParameterDefinitionByType(allParams, "query"),
+		CookieParams: FilterParameterDefinitionByType(allParams, "cookie"),
+		OperationId:  g.nameNormalizer(op.OperationID),
+		// Replace newlines in summary.
+		Summary:         op.Summary,
+		Method:          opName,
+		Path:            requestPath,
+		Spec:            op,
+		Bodies:          bodyDefinitions,
+		Responses:       responseDefinitions,
+		TypeDefinitions: typeDefinitions,
+		generator:       g,
+	}
 
-			if op.RequestBody != nil {
-				opDef.BodyRequired = op.RequestBody.Value.Required
-			}
+	// check for overrides of SecurityDefinitions.
+	// See: "Step 2. Applying security:" from the spec:
+	// https://swagger.io/docs/specification/authentication/
+	if op.Security != nil {
+		opDef.SecurityDefinitions = DescribeSecurityDefinition(*op.Security)
+		opDef.SecurityRequirements = DescribeSecurityRequirements(*op.Security)
+	} else {
+		// use global securityDefinitions
+		// globalSecurityDefinitions contains the top-level securityDefinitions.
+		// They are the default securityPermissions which are injected into each
+		// path, except for the case where a path explicitly overrides them.
+		opDef.SecurityDefinitions = DescribeSecurityDefinition(swagger.Security)
+		opDef.SecurityRequirements = DescribeSecurityRequirements(swagger.Security)
+	}
 
-			// Generate all the type definitions needed for this operation
-			opDef.TypeDefinitions = append(opDef.TypeDefinitions, GenerateTypeDefsForOperation(opDef)...)
+	if op.RequestBody != nil {
+		opDef.BodyRequired = op.RequestBody.Value.Required
+	}
 
-			operations = append(operations, opDef)
+	if extension, ok := op.Extensions[extIdempotent]; ok {
+		idempotent, err := extParseIdempotent(extension)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for %q of %s/%s: %w", extIdempotent, opName, requestPath, err)
 		}
+		opDef.Idempotent = &idempotent
 	}
-	return operations, nil
+
+	opDef.Pagination, err = g.describePagination(op, opDef.QueryParams, []string{op.OperationID})
+	if err != nil {
+		return nil, fmt.Errorf("invalid value for %q of %s/%s: %w", extPagination, opName, requestPath, err)
+	}
+
+	// Generate all the type definitions needed for this operation
+	opDef.TypeDefinitions = append(opDef.TypeDefinitions, g.GenerateTypeDefsForOperation(opDef)...)
+
+	return &opDef, nil
 }
 
-func generateDefaultOperationID(opName string, requestPath string, toCamelCaseFunc func(string) string) (string, error) {
+func (g *Generator) generateDefaultOperationID(opName string, requestPath string, toCamelCaseFunc func(string) string) (string, error) {
 	var operationId = strings.ToLower(opName)
 
 	if opName == "" {
@@ -673,16 +800,27 @@ func generateDefaultOperationID(opName string, requestPath string, toCamelCaseFu
 		}
 	}
 
-	return nameNormalizer(operationId), nil
+	return g.nameNormalizer(operationId), nil
 }
 
 // GenerateBodyDefinitions turns the Swagger body definitions into a list of our body
 // definitions which will be used for code generation.
+//
+// It uses the default Configuration; see Generator.GenerateBodyDefinitions.
 func GenerateBodyDefinitions(operationID string, bodyOrRef *openapi3.RequestBodyRef) ([]RequestBodyDefinition, []TypeDefinition, error) {
+	return defaultGenerator.GenerateBodyDefinitions(operationID, bodyOrRef)
+}
+
+// GenerateBodyDefinitions turns the Swagger body definitions into a list of
+// our body definitions, using the Generator's Configuration.
+func (g *Generator) GenerateBodyDefinitions(operationID string, bodyOrRef *openapi3.RequestBodyRef) ([]RequestBodyDefinition, []TypeDefinition, error) {
 	if bodyOrRef == nil {
 		return nil, nil, nil
 	}
 	body := bodyOrRef.Value
+	// Bodies are sent in requests, so refer to the request variants of the
+	// schemas which have them.
+	g = g.withVariant(requestVariant)
 
 	var bodyDefinitions []RequestBodyDefinition
 	var typeDefinitions []TypeDefinition
@@ -708,13 +846,14 @@ func GenerateBodyDefinitions(operationID string, bodyOrRef *openapi3.RequestBody
 			bd := RequestBodyDefinition{
 				Required:    body.Required,
 				ContentType: contentType,
+				generator:   g,
 			}
 			bodyDefinitions = append(bodyDefinitions, bd)
 			continue
 		}
 
 		bodyTypeName := operationID + tag + "Body"
-		bodySchema, err := GenerateGoSchema(content.Schema, []string{bodyTypeName})
+		bodySchema, err := g.GenerateGoSchema(content.Schema, []string{bodyTypeName})
 		if err != nil {
 			return nil, nil, fmt.Errorf("error generating request body definition: %w", err)
 		}
@@ -722,11 +861,11 @@ func GenerateBodyDefinitions(operationID string, bodyOrRef *openapi3.RequestBody
 		// If the body is a pre-defined type
 		if content.Schema != nil && IsGoTypeReference(content.Schema.Ref) {
 			// Convert the reference path to Go type
-			refType, err := RefPathToGoType(content.Schema.Ref)
+			refType, err := g.RefPathToGoType(content.Schema.Ref)
 			if err != nil {
 				return nil, nil, fmt.Errorf("error turning reference (%s) into a Go type: %w", content.Schema.Ref, err)
 			}
-			bodySchema.RefType = refType
+			bodySchema.RefType = g.variantGoType(content.Schema.Ref, refType)
 		}
 
 		// If the request has a body, but it's not a user defined
@@ -735,18 +874,22 @@ func GenerateBodyDefinitions(operationID string, bodyOrRef *openapi3.RequestBody
 		if bodySchema.RefType == "" {
 			if contentType == "application/x-www-form-urlencoded" {
 				// Apply the appropriate structure tag if the request
-				// schema was defined under the operations' section.
+				// schema was defined under the operations' section. The
+				// optional fields are pointers, as the form encoding doesn't
+				// support nullable.Nullable.
 				for i := range bodySchema.Properties {
 					bodySchema.Properties[i].NeedsFormTag = true
+					bodySchema.Properties[i].FieldRepresentation = FieldRepresentationPointer
 				}
 
 				// Regenerate the Golang struct adding the new form tag.
-				bodySchema.GoType = GenStructFromSchema(bodySchema)
+				bodySchema.GoType = g.GenStructFromSchema(bodySchema)
 			}
 
 			td := TypeDefinition{
-				TypeName: bodyTypeName,
-				Schema:   bodySchema,
+				TypeName:  bodyTypeName,
+				Schema:    bodySchema,
+				generator: g,
 			}
 			typeDefinitions = append(typeDefinitions, td)
 			// The body schema now is a reference to a type
@@ -759,6 +902,7 @@ func GenerateBodyDefinitions(operationID string, bodyOrRef *openapi3.RequestBody
 			NameTag:     tag,
 			ContentType: contentType,
 			Default:     defaultBody,
+			generator:   g,
 		}
 
 		if len(content.Encoding) != 0 {
@@ -777,10 +921,19 @@ func GenerateBodyDefinitions(operationID string, bodyOrRef *openapi3.RequestBody
 	return bodyDefinitions, typeDefinitions, nil
 }
 
+// GenerateResponseDefinitions calls Generator.GenerateResponseDefinitions using the default Configuration.
 func GenerateResponseDefinitions(operationID string, responses map[string]*openapi3.ResponseRef) ([]ResponseDefinition, error) {
+	return defaultGenerator.GenerateResponseDefinitions(operationID, responses)
+}
+
+// GenerateResponseDefinitions turns the Swagger responses of an operation into
+// a list of our response definitions, which will be used for code generation.
+func (g *Generator) GenerateResponseDefinitions(operationID string, responses map[string]*openapi3.ResponseRef) ([]ResponseDefinition, error) {
 	var responseDefinitions []ResponseDefinition
 	// do not let multiple status codes ref to same response, it will break the type switch
 	refSet := make(map[string]struct{})
+	// Refer to the response variants of the schemas which have them
+	g = g.withVariant(responseVariant)
 
 	for _, statusCode := range SortedMapKeys(responses) {
 		responseOrRef := responses[statusCode]
@@ -808,13 +961,22 @@ func GenerateResponseDefinitions(operationID string, responses map[string]*opena
 			default:
 				rcd := ResponseContentDefinition{
 					ContentType: contentType,
+					generator:   g,
+				}
+				if itemSchema := streamItemSchema(content); itemSchema != nil && isStreamMediaType(contentType) {
+					itemTypeName := operationID + statusCode + rcd.NameTagOrContentType() + "Item"
+					goSchema, err := g.GenerateGoSchema(itemSchema, []string{itemTypeName})
+					if err != nil {
+						return nil, fmt.Errorf("error generating stream item definition: %w", err)
+					}
+					rcd.ItemSchema = &goSchema
 				}
 				responseContentDefinitions = append(responseContentDefinitions, rcd)
 				continue
 			}
 
 			responseTypeName := operationID + statusCode + tag + "Response"
-			contentSchema, err := GenerateGoSchema(content.Schema, []string{responseTypeName})
+			contentSchema, err := g.GenerateGoSchema(content.Schema, []string{responseTypeName})
 			if err != nil {
 				return nil, fmt.Errorf("error generating request body definition: %w", err)
 			}
@@ -823,6 +985,7 @@ func GenerateResponseDefinitions(operationID string, responses map[string]*opena
 				ContentType: contentType,
 				NameTag:     tag,
 				Schema:      contentSchema,
+				generator:   g,
 			}
 
 			responseContentDefinitions = append(responseContentDefinitions, rcd)
@@ -831,11 +994,11 @@ func GenerateResponseDefinitions(operationID string, responses map[string]*opena
 		var responseHeaderDefinitions []ResponseHeaderDefinition
 		for _, headerName := range SortedMapKeys(response.Headers) {
 			header := response.Headers[headerName]
-			contentSchema, err := GenerateGoSchema(header.Value.Schema, []string{})
+			contentSchema, err := g.GenerateGoSchema(header.Value.Schema, []string{})
 			if err != nil {
 				return nil, fmt.Errorf("error generating response header definition: %w", err)
 			}
-			headerDefinition := ResponseHeaderDefinition{Name: headerName, GoName: SchemaNameToTypeName(headerName), Schema: contentSchema}
+			headerDefinition := ResponseHeaderDefinition{Name: headerName, GoName: g.SchemaNameToTypeName(headerName), Schema: contentSchema}
 			responseHeaderDefinitions = append(responseHeaderDefinitions, headerDefinition)
 		}
 
@@ -843,13 +1006,16 @@ func GenerateResponseDefinitions(operationID string, responses map[string]*opena
 			StatusCode: statusCode,
 			Contents:   responseContentDefinitions,
 			Headers:    responseHeaderDefinitions,
+			generator:  g,
 		}
 		if response.Description != nil {
 			rd.Description = *response.Description
 		}
 		if IsGoTypeReference(responseOrRef.Ref) {
-			// Convert the reference path to Go type
-			refType, err := RefPathToGoType(responseOrRef.Ref)
+			// Convert the reference path to Go type. The responses in the
+			// components of this spec are always generated alongside the
+			// server, so are referred to from this package.
+			refType, err := g.localRefPathToGoType(responseOrRef.Ref)
 			if err != nil {
 				return nil, fmt.Errorf("error turning reference (%s) into a Go type: %w", responseOrRef.Ref, err)
 			}
@@ -867,11 +1033,18 @@ func GenerateResponseDefinitions(operationID string, responses map[string]*opena
 	return responseDefinitions, nil
 }
 
+// GenerateTypeDefsForOperation calls Generator.GenerateTypeDefsForOperation using the default Configuration.
 func GenerateTypeDefsForOperation(op OperationDefinition) []TypeDefinition {
+	return defaultGenerator.GenerateTypeDefsForOperation(op)
+}
+
+// GenerateTypeDefsForOperation returns all the type definitions needed by the
+// parameters and bodies of an operation.
+func (g *Generator) GenerateTypeDefsForOperation(op OperationDefinition) []TypeDefinition {
 	var typeDefs []TypeDefinition
 	// Start with the params object itself
 	if len(op.Params()) != 0 {
-		typeDefs = append(typeDefs, GenerateParamsTypes(op)...)
+		typeDefs = append(typeDefs, g.GenerateParamsTypes(op)...)
 	}
 
 	// Now, go through all the additional types we need to declare.
@@ -887,7 +1060,15 @@ func GenerateTypeDefsForOperation(op OperationDefinition) []TypeDefinition {
 
 // GenerateParamsTypes defines the schema for a parameters definition object
 // which encapsulates all the query, header and cookie parameters for an operation.
+//
+// It uses the default Configuration; see Generator.GenerateParamsTypes.
 func GenerateParamsTypes(op OperationDefinition) []TypeDefinition {
+	return defaultGenerator.GenerateParamsTypes(op)
+}
+
+// GenerateParamsTypes defines the schema for the parameters definition object
+// of an operation, as the package level GenerateParamsTypes.
+func (g *Generator) GenerateParamsTypes(op OperationDefinition) []TypeDefinition {
 	var typeDefs []TypeDefinition
 
 	objectParams := op.QueryParams
@@ -904,8 +1085,9 @@ func GenerateParamsTypes(op OperationDefinition) []TypeDefinition {
 			propRefName := strings.Join([]string{typeName, param.GoName()}, "_")
 			pSchema.RefType = propRefName
 			typeDefs = append(typeDefs, TypeDefinition{
-				TypeName: propRefName,
-				Schema:   param.Schema,
+				TypeName:  propRefName,
+				Schema:    param.Schema,
+				generator: g,
 			})
 		}
 		prop := Property{
@@ -915,16 +1097,18 @@ func GenerateParamsTypes(op OperationDefinition) []TypeDefinition {
 			Schema:        pSchema,
 			NeedsFormTag:  param.Style() == "form",
 			Extensions:    param.Spec.Extensions,
+			generator:     g,
 		}
 		s.Properties = append(s.Properties, prop)
 	}
 
 	s.Description = op.Spec.Description
-	s.GoType = GenStructFromSchema(s)
+	s.GoType = g.GenStructFromSchema(s)
 
 	td := TypeDefinition{
-		TypeName: typeName,
-		Schema:   s,
+		TypeName:  typeName,
+		Schema:    s,
+		generator: g,
 	}
 	return append(typeDefs, td)
 }
@@ -986,6 +1170,12 @@ func GenerateFiberServer(t *template.Template, operations []OperationDefinition)
 	return GenerateTemplates([]string{"fiber/fiber-interface.tmpl", "fiber/fiber-middleware.tmpl", "fiber/fiber-handler.tmpl"}, t, operations)
 }
 
+// GenerateFiberV3Server generates all the go code for the ServerInterface as well as
+// all the wrapper functions around our handlers.
+func GenerateFiberV3Server(t *template.Template, operations []OperationDefinition) (string, error) {
+	return GenerateTemplates([]string{"fiberv3/fiber-v3-interface.tmpl", "fiberv3/fiber-v3-middleware.tmpl", "fiberv3/fiber-v3-handler.tmpl"}, t, operations)
+}
+
 // GenerateEchoServer generates all the go code for the ServerInterface as well as
 // all the wrapper functions around our handlers.
 func GenerateEchoServer(t *template.Template, operations []OperationDefinition) (string, error) {
@@ -998,6 +1188,12 @@ func GenerateGinServer(t *template.Template, operations []OperationDefinition) (
 	return GenerateTemplates([]string{"gin/gin-interface.tmpl", "gin/gin-wrappers.tmpl", "gin/gin-register.tmpl"}, t, operations)
 }
 
+// GenerateHertzServer generates all the go code for the ServerInterface as well as
+// all the wrapper functions around our handlers.
+func GenerateHertzServer(t *template.Template, operations []OperationDefinition) (string, error) {
+	return GenerateTemplates([]string{"hertz/hertz-interface.tmpl", "hertz/hertz-wrappers.tmpl", "hertz/hertz-register.tmpl"}, t, operations)
+}
+
 // GenerateGorillaServer generates all the go code for the ServerInterface as well as
 // all the wrapper functions around our handlers.
 func GenerateGorillaServer(t *template.Template, operations []OperationDefinition) (string, error) {
@@ -1010,11 +1206,54 @@ func GenerateStdHTTPServer(t *template.Template, operations []OperationDefinitio
 	return GenerateTemplates([]string{"stdhttp/std-http-interface.tmpl", "stdhttp/std-http-middleware.tmpl", "stdhttp/std-http-handler.tmpl"}, t, operations)
 }
 
+// GenerateHttprouterServer generates all the go code for the ServerInterface as well as
+// all the wrapper functions around our handlers.
+func GenerateHttprouterServer(t *template.Template, operations []OperationDefinition) (string, error) {
+	if err := checkHttprouterRoutes(operations); err != nil {
+		return "", err
+	}
+	return GenerateTemplates([]string{"httprouter/httprouter-interface.tmpl", "httprouter/httprouter-middleware.tmpl", "httprouter/httprouter-handler.tmpl"}, t, operations)
+}
+
+// checkHttprouterRoutes returns an error when the routes of two operations
+// with the same method conflict in httprouter, which panics when registering
+// them. A path parameter conflicts with any other segment at the same position
+// of a path with the same preceding segments, such as /pets/{id} and
+// /pets/search, unless it's a path parameter of the same name.
+func checkHttprouterRoutes(operations []OperationDefinition) error {
+	for i, op := range operations {
+		segments := strings.Split(SwaggerUriToHttprouterUri(op.Path), "/")
+		for _, other := range operations[:i] {
+			if other.Method != op.Method {
+				continue
+			}
+			otherSegments := strings.Split(SwaggerUriToHttprouterUri(other.Path), "/")
+			for j := 0; j < len(segments) && j < len(otherSegments); j++ {
+				if segments[j] == otherSegments[j] {
+					continue
+				}
+				if strings.HasPrefix(segments[j], ":") || strings.HasPrefix(otherSegments[j], ":") {
+					return fmt.Errorf("the path %s of the operation %s conflicts with the path %s of the operation %s, as httprouter doesn't allow a path parameter alongside other segments at the same position",
+						op.Path, op.OperationId, other.Path, other.OperationId)
+				}
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// GenerateFasthttpServer generates all the go code for the ServerInterface as well as
+// all the wrapper functions around our handlers.
+func GenerateFasthttpServer(t *template.Template, operations []OperationDefinition) (string, error) {
+	return GenerateTemplates([]string{"fasthttp/fasthttp-interface.tmpl", "fasthttp/fasthttp-middleware.tmpl", "fasthttp/fasthttp-handler.tmpl"}, t, operations)
+}
+
 func GenerateStrictServer(t *template.Template, operations []OperationDefinition, opts Configuration) (string, error) {
 
 	var templates []string
 
-	if opts.Generate.ChiServer || opts.Generate.GorillaServer || opts.Generate.StdHTTPServer {
+	if opts.Generate.ChiServer || opts.Generate.GorillaServer || opts.Generate.StdHTTPServer || opts.Generate.HttprouterServer {
 		templates = append(templates, "strict/strict-interface.tmpl", "strict/strict-http.tmpl")
 	}
 	if opts.Generate.EchoServer {
@@ -1023,9 +1262,18 @@ func GenerateStrictServer(t *template.Template, operations []OperationDefinition
 	if opts.Generate.GinServer {
 		templates = append(templates, "strict/strict-interface.tmpl", "strict/strict-gin.tmpl")
 	}
+	if opts.Generate.HertzServer {
+		templates = append(templates, "strict/strict-interface.tmpl", "strict/strict-hertz.tmpl")
+	}
 	if opts.Generate.FiberServer {
 		templates = append(templates, "strict/strict-fiber-interface.tmpl", "strict/strict-fiber.tmpl")
 	}
+	if opts.Generate.FiberV3Server {
+		templates = append(templates, "strict/strict-fiber-v3-interface.tmpl", "strict/strict-fiber-v3.tmpl")
+	}
+	if opts.Generate.FasthttpServer {
+		templates = append(templates, "strict/strict-fasthttp-interface.tmpl", "strict/strict-fasthttp.tmpl")
+	}
 	if opts.Generate.IrisServer {
 		templates = append(templates, "strict/strict-iris-interface.tmpl", "strict/strict-iris.tmpl")
 	}
@@ -1033,6 +1281,72 @@ func GenerateStrictServer(t *template.Template, operations []OperationDefinition
 	return GenerateTemplates(templates, t, operations)
 }
 
+// GenerateStrictResponseValidation generates a strict middleware for the
+// strict server which validates responses against the embedded spec.
+func GenerateStrictResponseValidation(t *template.Template, operations []OperationDefinition, opts Configuration) (string, error) {
+	templates := []string{"strict/strict-response-validation.tmpl"}
+
+	if opts.Generate.ChiServer || opts.Generate.GorillaServer || opts.Generate.StdHTTPServer || opts.Generate.HttprouterServer {
+		templates = append(templates, "strict/strict-response-validation-interface.tmpl", "strict/strict-response-validation-http.tmpl")
+	}
+	if opts.Generate.EchoServer {
+		templates = append(templates, "strict/strict-response-validation-interface.tmpl", "strict/strict-response-validation-echo.tmpl")
+	}
+	if opts.Generate.GinServer {
+		templates = append(templates, "strict/strict-response-validation-interface.tmpl", "strict/strict-response-validation-gin.tmpl")
+	}
+	if opts.Generate.HertzServer {
+		templates = append(templates, "strict/strict-response-validation-interface.tmpl", "strict/strict-response-validation-hertz.tmpl")
+	}
+	if opts.Generate.FiberServer {
+		templates = append(templates, "strict/strict-response-validation-fiber.tmpl")
+	}
+	if opts.Generate.FiberV3Server {
+		templates = append(templates, "strict/strict-response-validation-fiber-v3.tmpl")
+	}
+	if opts.Generate.FasthttpServer {
+		templates = append(templates, "strict/strict-response-validation-fasthttp.tmpl")
+	}
+	if opts.Generate.IrisServer {
+		templates = append(templates, "strict/strict-response-validation-iris.tmpl")
+	}
+
+	return GenerateTemplates(templates, t, operations)
+}
+
+// GenerateValidationMiddleware generates a middleware for the generated server
+// which validates requests against the embedded spec.
+func GenerateValidationMiddleware(t *template.Template, opts Configuration) (string, error) {
+	templates := []string{"validation/validation.tmpl"}
+
+	if opts.Generate.ChiServer || opts.Generate.GorillaServer || opts.Generate.StdHTTPServer || opts.Generate.HttprouterServer {
+		templates = append(templates, "validation/validation-http.tmpl")
+	}
+	if opts.Generate.EchoServer {
+		templates = append(templates, "validation/validation-echo.tmpl")
+	}
+	if opts.Generate.GinServer {
+		templates = append(templates, "validation/validation-gin.tmpl")
+	}
+	if opts.Generate.HertzServer {
+		templates = append(templates, "validation/validation-hertz.tmpl")
+	}
+	if opts.Generate.FiberServer {
+		templates = append(templates, "validation/validation-fiber.tmpl")
+	}
+	if opts.Generate.FiberV3Server {
+		templates = append(templates, "validation/validation-fiber-v3.tmpl")
+	}
+	if opts.Generate.FasthttpServer {
+		templates = append(templates, "validation/validation-fasthttp.tmpl")
+	}
+	if opts.Generate.IrisServer {
+		templates = append(templates, "validation/validation-iris.tmpl")
+	}
+
+	return GenerateTemplates(templates, t, nil)
+}
+
 func GenerateStrictResponses(t *template.Template, responses []ResponseDefinition) (string, error) {
 	return GenerateTemplates([]string{"strict/strict-responses.tmpl"}, t, responses)
 }
@@ -1040,13 +1354,14 @@ func GenerateStrictResponses(t *template.Template, responses []ResponseDefinitio
 // GenerateClient uses the template engine to generate the function which registers our wrappers
 // as Echo path handlers.
 func GenerateClient(t *template.Template, ops []OperationDefinition) (string, error) {
-	return GenerateTemplates([]string{"client.tmpl"}, t, ops)
+	return GenerateTemplates([]string{"client.tmpl", "client-retry.tmpl"}, t, ops)
 }
 
 // GenerateClientWithResponses generates a client which extends the basic client which does response
-// unmarshaling.
+// unmarshaling, along with iterators over the items of paginated operations, and
+// decoders of streamed responses.
 func GenerateClientWithResponses(t *template.Template, ops []OperationDefinition) (string, error) {
-	return GenerateTemplates([]string{"client-with-responses.tmpl"}, t, ops)
+	return GenerateTemplates([]string{"client-with-responses.tmpl", "client-pagination.tmpl", "client-stream.tmpl"}, t, ops)
 }
 
 // GenerateTemplates used to generate templates
@@ -131,7 +131,7 @@ func TestGenerateDefaultOperationID(t *testing.T) {
 	}
 
 	for _, test := range suite {
-		got, err := generateDefaultOperationID(test.op, test.path, ToCamelCase)
+		got, err := defaultGenerator.generateDefaultOperationID(test.op, test.path, ToCamelCase)
 		if err != nil {
 			if !test.wantErr {
 				t.Fatalf("did not expected error but got %v", err)
@@ -146,3 +146,53 @@ func TestGenerateDefaultOperationID(t *testing.T) {
 		}
 	}
 }
+
+func TestIsIdempotent(t *testing.T) {
+	idempotent, notIdempotent := true, false
+
+	suite := []struct {
+		method     string
+		idempotent *bool
+		want       bool
+	}{
+		{method: "GET", want: true},
+		{method: "PUT", want: true},
+		{method: "DELETE", want: true},
+		{method: "POST", want: false},
+		{method: "PATCH", want: false},
+		{method: "POST", idempotent: &idempotent, want: true},
+		{method: "PUT", idempotent: &notIdempotent, want: false},
+	}
+
+	for _, test := range suite {
+		op := OperationDefinition{Method: test.method, Idempotent: test.idempotent}
+		if got := op.IsIdempotent(); got != test.want {
+			t.Fatalf("IsIdempotent for %s failed. Want [%v] Got [%v]", test.method, test.want, got)
+		}
+	}
+}
+
+func TestIdempotentExtension(t *testing.T) {
+	swagger := &openapi3.T{
+		Paths: openapi3.NewPaths(openapi3.WithPath("/search", &openapi3.PathItem{
+			Post: &openapi3.Operation{
+				OperationID: "search",
+				Extensions:  map[string]interface{}{extIdempotent: true},
+				Responses:   openapi3.NewResponses(),
+			},
+		})),
+	}
+
+	ops, err := defaultGenerator.OperationDefinitions(swagger, false)
+	if err != nil {
+		t.Fatalf("did not expect error but got %v", err)
+	}
+	if len(ops) != 1 || ops[0].Idempotent == nil || !*ops[0].Idempotent {
+		t.Fatalf("expected search to be idempotent")
+	}
+
+	swagger.Paths.Value("/search").Post.Extensions[extIdempotent] = "yes"
+	if _, err := defaultGenerator.OperationDefinitions(swagger, false); err == nil {
+		t.Fatalf("expected an error for an invalid %s", extIdempotent)
+	}
+}
@@ -0,0 +1,61 @@
+package codegen
+
+import (
+	"fmt"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// FieldRepresentation is how the optional and nullable properties of objects
+// are represented in the generated structs.
+type FieldRepresentation string
+
+const (
+	// FieldRepresentationPointer represents the optional and nullable
+	// properties with pointers, or with `nullable.Nullable` when the
+	// `nullable-type` option is set. This is the default.
+	FieldRepresentationPointer FieldRepresentation = "pointer"
+	// FieldRepresentationOptional represents the optional and nullable
+	// properties with `nullable.Nullable`, which tells apart the properties
+	// which are unset from those which are null.
+	FieldRepresentationOptional FieldRepresentation = "optional"
+)
+
+// FieldRepresentations are the supported field representations.
+var FieldRepresentations = []FieldRepresentation{FieldRepresentationPointer, FieldRepresentationOptional}
+
+// Valid returns whether the field representation is supported. The empty
+// field representation is the default one.
+func (r FieldRepresentation) Valid() bool {
+	switch r {
+	case "", FieldRepresentationPointer, FieldRepresentationOptional:
+		return true
+	}
+	return false
+}
+
+// fieldRepresentation returns the representation of the property of the
+// object schema, which is set by the `x-go-field-representation` extension of
+// the property, or else of the object, or else by the `field-representation`
+// output option.
+func (g *Generator) fieldRepresentation(object *openapi3.Schema, property *openapi3.SchemaRef) (FieldRepresentation, error) {
+	representation := FieldRepresentation(g.opts.OutputOptions.FieldRepresentation)
+	schemas := []*openapi3.Schema{object}
+	// The extensions of a referenced schema apply to its own properties
+	if property.Ref == "" && property.Value != nil {
+		schemas = append(schemas, property.Value)
+	}
+	for _, schema := range schemas {
+		if extension, ok := schema.Extensions[extFieldRepresentation]; ok {
+			r, err := extParseFieldRepresentation(extension)
+			if err != nil {
+				return "", fmt.Errorf("invalid value for %q: %w", extFieldRepresentation, err)
+			}
+			representation = r
+		}
+	}
+	if representation == "" {
+		representation = FieldRepresentationPointer
+	}
+	return representation, nil
+}
@@ -0,0 +1,106 @@
+package codegen
+
+import (
+	"go/format"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const optionalSpec = `
+openapi: "3.0.0"
+info:
+  version: 1.0.0
+  title: Optional fields
+paths: {}
+components:
+  schemas:
+    Pet:
+      type: object
+      required: [id, tag]
+      properties:
+        id:
+          type: integer
+        name:
+          type: string
+        tag:
+          type: string
+          nullable: true
+        nickname:
+          type: string
+          x-go-type-skip-optional-pointer: true
+    Owner:
+      type: object
+      x-go-field-representation: optional
+      properties:
+        name:
+          type: string
+        email:
+          type: string
+          x-go-field-representation: pointer
+`
+
+func TestGenerateOptionalFields(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(optionalSpec))
+	require.NoError(t, err)
+
+	code, err := Generate(swagger, Configuration{
+		PackageName:   "api",
+		Generate:      GenerateOptions{Models: true},
+		OutputOptions: OutputOptions{SkipPrune: true, FieldRepresentation: string(FieldRepresentationOptional)},
+	})
+	require.NoError(t, err)
+	_, err = format.Source([]byte(code))
+	require.NoError(t, err)
+
+	assert.Contains(t, code, `"github.com/oapi-codegen/nullable"`)
+	assert.Contains(t, code, "Id       int                       `json:\"id\"`")
+	assert.Contains(t, code, "Name     nullable.Nullable[string] `json:\"name,omitempty\"`")
+	// The required nullable properties may be null, but not unset
+	assert.Contains(t, code, "Tag      nullable.Nullable[string] `json:\"tag\"`")
+	assert.Contains(t, code, "Nickname string                    `json:\"nickname,omitempty\"`")
+
+	// The extension of the property overrides the option
+	assert.Contains(t, code, "Email *string                   `json:\"email,omitempty\"`")
+}
+
+func TestGenerateOptionalFieldsExtension(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(optionalSpec))
+	require.NoError(t, err)
+
+	code, err := Generate(swagger, Configuration{
+		PackageName:   "api",
+		Generate:      GenerateOptions{Models: true},
+		OutputOptions: OutputOptions{SkipPrune: true},
+	})
+	require.NoError(t, err)
+
+	assert.Contains(t, code, "Name  nullable.Nullable[string] `json:\"name,omitempty\"`")
+	assert.Contains(t, code, "Email *string                   `json:\"email,omitempty\"`")
+	assert.Contains(t, code, "Name     *string `json:\"name,omitempty\"`")
+	assert.Contains(t, code, "Tag      *string `json:\"tag\"`")
+}
+
+func TestInvalidFieldRepresentation(t *testing.T) {
+	err := Configuration{
+		PackageName:   "api",
+		Generate:      GenerateOptions{Models: true},
+		OutputOptions: OutputOptions{FieldRepresentation: "value"},
+	}.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "`output-options` configuration for field-representation was incorrect: must be one of [\"pointer\" \"optional\"]")
+
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(optionalSpec))
+	require.NoError(t, err)
+	swagger.Components.Schemas["Owner"].Value.Extensions[extFieldRepresentation] = "value"
+
+	_, err = Generate(swagger, Configuration{
+		PackageName:   "api",
+		Generate:      GenerateOptions{Models: true},
+		OutputOptions: OutputOptions{SkipPrune: true},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `invalid value for "x-go-field-representation": unknown field representation "value"`)
+}
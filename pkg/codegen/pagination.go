@@ -0,0 +1,135 @@
+package codegen
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+
+	"github.com/oapi-codegen/oapi-codegen/v2/pkg/util"
+)
+
+// extPagination describes how the pages of a list operation are fetched, for
+// generating iterators over all of its items in the client
+const extPagination = "x-pagination"
+
+// paginationExtension is the value of the x-pagination extension. Either the
+// next page is requested by setting CursorParam to the cursor found at
+// NextCursorPath in the response, or by following the "next" link of the
+// response's Link header.
+type paginationExtension struct {
+	// CursorParam is the query parameter which selects the page
+	CursorParam string `json:"cursor-param"`
+	// NextCursorPath is the path to the cursor of the next page within the
+	// JSON response, such as meta.next_cursor
+	NextCursorPath string `json:"next-cursor-path"`
+	// LinkHeader follows the RFC 5988 Link header with the "next" relation
+	LinkHeader bool `json:"link-header"`
+	// ItemsPath is the path to the array of items within the JSON response,
+	// such as data. It's empty when the response is the array itself.
+	ItemsPath string `json:"items-path"`
+}
+
+// PaginationDefinition describes how the client iterates over all the items of
+// a paginated operation.
+type PaginationDefinition struct {
+	// CursorParam is the query parameter which selects the page, if the pages
+	// are selected by a cursor
+	CursorParam *ParameterDefinition
+	// NextCursorPath is the path to the cursor of the next page within the
+	// response
+	NextCursorPath []string
+	// LinkHeader is whether the next page is found in the Link header
+	LinkHeader bool
+	// ItemsPath is the path to the array of items within the response
+	ItemsPath []string
+	// ItemType is the Go type of the items
+	ItemType string
+}
+
+// splitJSONPath splits a dot-separated path within a JSON document, with an
+// optional leading $, into its keys.
+func splitJSONPath(path string) []string {
+	path = strings.TrimPrefix(strings.TrimPrefix(path, "$"), ".")
+	if path == "" {
+		return nil
+	}
+	return strings.Split(path, ".")
+}
+
+// describePagination describes the pagination of an operation with the
+// x-pagination extension, or returns nil if it doesn't have one.
+func (g *Generator) describePagination(op *openapi3.Operation, queryParams []ParameterDefinition, path []string) (*PaginationDefinition, error) {
+	extension, ok := op.Extensions[extPagination]
+	if !ok {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(extension)
+	if err != nil {
+		return nil, err
+	}
+	var ext paginationExtension
+	if err := json.Unmarshal(data, &ext); err != nil {
+		return nil, err
+	}
+
+	if op.RequestBody != nil {
+		return nil, errors.New("only operations without a request body can be paginated")
+	}
+
+	pagination := &PaginationDefinition{
+		LinkHeader: ext.LinkHeader,
+		ItemsPath:  splitJSONPath(ext.ItemsPath),
+	}
+	switch {
+	case ext.LinkHeader && (ext.CursorParam != "" || ext.NextCursorPath != ""):
+		return nil, errors.New("`link-header` can't be combined with `cursor-param` or `next-cursor-path`")
+	case ext.LinkHeader:
+	case ext.CursorParam == "" || ext.NextCursorPath == "":
+		return nil, errors.New("either `cursor-param` and `next-cursor-path`, or `link-header` must be set")
+	default:
+		for i := range queryParams {
+			if queryParams[i].ParamName == ext.CursorParam {
+				pagination.CursorParam = &queryParams[i]
+			}
+		}
+		if pagination.CursorParam == nil {
+			return nil, fmt.Errorf("`cursor-param` %s isn't a query parameter of the operation", ext.CursorParam)
+		}
+		pagination.NextCursorPath = splitJSONPath(ext.NextCursorPath)
+	}
+
+	// The items are found in the JSON response to a successful request
+	var schema *openapi3.SchemaRef
+	if response := op.Responses.Status(200); response != nil && response.Value != nil {
+		for _, contentType := range SortedMapKeys(response.Value.Content) {
+			if util.IsMediaTypeJson(contentType) {
+				schema = response.Value.Content[contentType].Schema
+				break
+			}
+		}
+	}
+	if schema == nil {
+		return nil, errors.New("the operation has no JSON response with a 200 status code")
+	}
+	for _, key := range pagination.ItemsPath {
+		if schema.Value == nil || schema.Value.Properties[key] == nil {
+			return nil, fmt.Errorf("`items-path` %q isn't in the response", ext.ItemsPath)
+		}
+		schema = schema.Value.Properties[key]
+	}
+	if schema.Value == nil || !schema.Value.Type.Is("array") || schema.Value.Items == nil {
+		return nil, fmt.Errorf("`items-path` %q doesn't refer to an array", ext.ItemsPath)
+	}
+
+	itemSchema, err := g.withVariant(responseVariant).GenerateGoSchema(schema.Value.Items, append(path, "Item"))
+	if err != nil {
+		return nil, fmt.Errorf("error generating type for items: %w", err)
+	}
+	pagination.ItemType = itemSchema.TypeDecl()
+
+	return pagination, nil
+}
@@ -0,0 +1,150 @@
+package codegen
+
+import (
+	"go/format"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const paginationSpec = `
+openapi: "3.0.0"
+info:
+  version: 1.0.0
+  title: Pagination
+paths:
+  /things:
+    get:
+      operationId: listThings
+      x-pagination:
+        cursor-param: cursor
+        next-cursor-path: $.meta.next
+        items-path: data
+      parameters:
+        - name: cursor
+          in: query
+          schema:
+            type: string
+      responses:
+        '200':
+          description: A page of things
+          content:
+            application/json:
+              schema:
+                type: object
+                properties:
+                  data:
+                    type: array
+                    items:
+                      $ref: '#/components/schemas/Thing'
+                  meta:
+                    type: object
+                    properties:
+                      next:
+                        type: string
+  /names:
+    get:
+      operationId: listNames
+      x-pagination:
+        link-header: true
+      responses:
+        '200':
+          description: A page of names
+          content:
+            application/json:
+              schema:
+                type: array
+                items:
+                  type: string
+components:
+  schemas:
+    Thing:
+      type: object
+      properties:
+        name:
+          type: string
+`
+
+func loadPaginationSpec(t *testing.T) *openapi3.T {
+	t.Helper()
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(paginationSpec))
+	require.NoError(t, err)
+	return swagger
+}
+
+func TestDescribePagination(t *testing.T) {
+	ops, err := defaultGenerator.OperationDefinitions(loadPaginationSpec(t), false)
+	require.NoError(t, err)
+	require.Len(t, ops, 2)
+
+	names := ops[0].Pagination
+	require.NotNil(t, names)
+	assert.True(t, names.LinkHeader)
+	assert.Nil(t, names.CursorParam)
+	assert.Empty(t, names.ItemsPath)
+	assert.Equal(t, "string", names.ItemType)
+
+	things := ops[1].Pagination
+	require.NotNil(t, things)
+	assert.False(t, things.LinkHeader)
+	require.NotNil(t, things.CursorParam)
+	assert.Equal(t, "cursor", things.CursorParam.ParamName)
+	assert.Equal(t, []string{"meta", "next"}, things.NextCursorPath)
+	assert.Equal(t, []string{"data"}, things.ItemsPath)
+	assert.Equal(t, "Thing", things.ItemType)
+}
+
+func TestDescribePaginationInvalid(t *testing.T) {
+	tests := []struct {
+		name      string
+		extension map[string]interface{}
+		err       string
+	}{
+		{"missing cursor path", map[string]interface{}{"cursor-param": "cursor"}, "either `cursor-param` and `next-cursor-path`, or `link-header` must be set"},
+		{"both", map[string]interface{}{"link-header": true, "cursor-param": "cursor"}, "can't be combined"},
+		{"unknown param", map[string]interface{}{"cursor-param": "page", "next-cursor-path": "meta.next", "items-path": "data"}, "`cursor-param` page isn't a query parameter"},
+		{"unknown items", map[string]interface{}{"link-header": true, "items-path": "items"}, "`items-path` \"items\" isn't in the response"},
+		{"not an array", map[string]interface{}{"link-header": true, "items-path": "meta"}, "`items-path` \"meta\" doesn't refer to an array"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			swagger := loadPaginationSpec(t)
+			swagger.Paths.Value("/things").Get.Extensions[extPagination] = tt.extension
+
+			_, err := defaultGenerator.OperationDefinitions(swagger, false)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.err)
+		})
+	}
+}
+
+func TestGeneratePagination(t *testing.T) {
+	opts := Configuration{
+		PackageName: "api",
+		Generate: GenerateOptions{
+			Models: true,
+			Client: true,
+		},
+	}
+
+	code, err := Generate(loadPaginationSpec(t), opts)
+	require.NoError(t, err)
+	_, err = format.Source([]byte(code))
+	require.NoError(t, err)
+
+	assert.Contains(t, code, `"iter"`)
+	assert.Contains(t, code, "func (c *ClientWithResponses) ListThingsAll(ctx context.Context, params *ListThingsParams, reqEditors ...RequestEditorFn) iter.Seq2[Thing, error] {")
+	assert.Contains(t, code, "func (c *ClientWithResponses) ListNamesAll(ctx context.Context, reqEditors ...RequestEditorFn) iter.Seq2[string, error] {")
+	assert.Contains(t, code, "pageParams.Cursor = &cursor")
+	assert.Contains(t, code, "if value == nil || string(value) == `\"\"` || requested[string(value)] {")
+	assert.Contains(t, code, "func nextPageLink(rsp *http.Response) *url.URL {")
+
+	opts.OutputOptions.PaginationCallbacks = true
+	code, err = Generate(loadPaginationSpec(t), opts)
+	require.NoError(t, err)
+
+	assert.NotContains(t, code, `"iter"`)
+	assert.Contains(t, code, "func (c *ClientWithResponses) ListThingsAll(ctx context.Context, params *ListThingsParams, fn func(item Thing) bool, reqEditors ...RequestEditorFn) error {")
+}
@@ -22,11 +22,29 @@ type RefWrapper struct {
 }
 
 func walkSwagger(swagger *openapi3.T, doFn func(RefWrapper) (bool, error)) error {
-	if swagger == nil || swagger.Paths == nil {
+	if swagger == nil {
+		return nil
+	}
+	webhooks := specWebhooks(swagger)
+	if swagger.Paths == nil && len(webhooks) == 0 {
 		return nil
 	}
 
-	for _, p := range swagger.Paths.Map() {
+	if swagger.Paths != nil {
+		for _, p := range swagger.Paths.Map() {
+			for _, param := range p.Parameters {
+				_ = walkParameterRef(param, doFn)
+			}
+			for _, op := range p.Operations() {
+				_ = walkOperation(op, doFn)
+			}
+		}
+	}
+
+	for _, p := range webhooks {
+		if p == nil {
+			continue
+		}
 		for _, param := range p.Parameters {
 			_ = walkParameterRef(param, doFn)
 		}
@@ -245,6 +263,7 @@ func walkResponseRef(ref *openapi3.ResponseRef, doFn func(RefWrapper) (bool, err
 			continue
 		}
 		_ = walkSchemaRef(mediaType.Schema, doFn)
+		_ = walkSchemaRef(streamItemSchema(mediaType), doFn)
 
 		for _, example := range mediaType.Examples {
 			_ = walkExampleRef(example, doFn)
@@ -29,6 +29,7 @@ type Schema struct {
 
 	UnionElements []UnionElement // Possible elements of oneOf/anyOf union
 	Discriminator *Discriminator // Describes which value is stored in a union
+	SealedUnion   bool           // Whether the union, or the union referred to, is represented by a sealed interface
 
 	// If this is set, the schema will declare a type via alias, eg,
 	// `type Foo = bool`. If this is not set, we will define this type via
@@ -90,9 +91,17 @@ type Property struct {
 	NeedsFormTag  bool
 	Extensions    map[string]interface{}
 	Deprecated    bool
+
+	// FieldRepresentation is how the property is represented when it's
+	// optional or nullable
+	FieldRepresentation FieldRepresentation
+
+	// generator is the Generator which created the property, if any
+	generator *Generator
 }
 
 func (p Property) GoFieldName() string {
+	g := p.generator.orDefault()
 	goFieldName := p.JsonFieldName
 	if extension, ok := p.Extensions[extGoName]; ok {
 		if extGoFieldName, err := extParseGoFieldName(extension); err == nil {
@@ -100,7 +109,7 @@ func (p Property) GoFieldName() string {
 		}
 	}
 
-	if globalState.options.Compatibility.AllowUnexportedStructFieldNames {
+	if g.opts.Compatibility.AllowUnexportedStructFieldNames {
 		if extension, ok := p.Extensions[extOapiCodegenOnlyHonourGoName]; ok {
 			if extOapiCodegenOnlyHonourGoName, err := extParseOapiCodegenOnlyHonourGoName(extension); err == nil {
 				if extOapiCodegenOnlyHonourGoName {
@@ -110,24 +119,38 @@ func (p Property) GoFieldName() string {
 		}
 	}
 
-	return SchemaNameToTypeName(goFieldName)
+	return g.SchemaNameToTypeName(goFieldName)
 }
 
 func (p Property) GoTypeDef() string {
+	g := p.generator.orDefault()
 	typeDef := p.Schema.TypeDecl()
-	if globalState.options.OutputOptions.NullableType && p.Nullable {
+	if p.isOptional() || (g.opts.OutputOptions.NullableType && p.Nullable) {
 		return "nullable.Nullable[" + typeDef + "]"
 	}
-	if !p.Schema.SkipOptionalPointer &&
-		(!p.Required || p.Nullable ||
-			(p.ReadOnly && (!p.Required || !globalState.options.Compatibility.DisableRequiredReadOnlyAsPointer)) ||
-			p.WriteOnly) {
-
+	if p.isPointer() {
 		typeDef = "*" + typeDef
 	}
 	return typeDef
 }
 
+// isPointer returns whether the property is represented with a pointer, as
+// it's optional or nullable.
+func (p Property) isPointer() bool {
+	g := p.generator.orDefault()
+	return !p.Schema.SkipOptionalPointer &&
+		(!p.Required || p.Nullable ||
+			(p.ReadOnly && (!p.Required || !g.opts.Compatibility.DisableRequiredReadOnlyAsPointer)) ||
+			p.WriteOnly)
+}
+
+// isOptional returns whether the property is represented with the
+// nullable.Nullable type by its field representation, rather than a pointer.
+// Nullable properties always are, so they can be null.
+func (p Property) isOptional() bool {
+	return p.FieldRepresentation == FieldRepresentationOptional && (p.Nullable || p.isPointer())
+}
+
 // EnumDefinition holds type information for enum
 type EnumDefinition struct {
 	// Schema is the scheme of a type which has a list of enum values, eg, the
@@ -160,6 +183,71 @@ func (e *EnumDefinition) GetValues() map[string]string {
 	return newValues
 }
 
+// HasHelpers returns whether the enum's type gets the generated helpers, such
+// as Valid() and String(), which are generated for string and integer enums
+// with `output-options.enum-helpers`.
+func (e *EnumDefinition) HasHelpers() bool {
+	return e.IsString() || e.IsInteger()
+}
+
+// IsString returns whether the enum's type is a string.
+func (e *EnumDefinition) IsString() bool {
+	return e.Schema.GoType == "string"
+}
+
+// IsInteger returns whether the enum's type is one of Go's integer types.
+func (e *EnumDefinition) IsInteger() bool {
+	return e.IntegerBitSize() >= 0
+}
+
+// IsUnsigned returns whether the enum's type is one of Go's unsigned integer
+// types.
+func (e *EnumDefinition) IsUnsigned() bool {
+	return e.IsInteger() && strings.HasPrefix(e.Schema.GoType, "uint")
+}
+
+// IntegerBitSize returns the bit size of the enum's integer type, as passed to
+// strconv.ParseInt, which is 0 for int and uint, or -1 if it isn't an integer.
+func (e *EnumDefinition) IntegerBitSize() int {
+	switch e.Schema.GoType {
+	case "int", "uint":
+		return 0
+	case "int8", "uint8":
+		return 8
+	case "int16", "uint16":
+		return 16
+	case "int32", "uint32":
+		return 32
+	case "int64", "uint64":
+		return 64
+	}
+	return -1
+}
+
+// GetValueNamesInOrder returns the names of the enum's values, as returned by
+// GetValues, in the order the values are declared in the spec.
+func (e *EnumDefinition) GetValueNamesInOrder() []string {
+	names := map[string]string{}
+	values := e.GetValues()
+	for _, name := range SortedMapKeys(values) {
+		if _, found := names[values[name]]; !found {
+			names[values[name]] = name
+		}
+	}
+
+	var ordered []string
+	for _, v := range enumValuesInOrder(e.Schema) {
+		ordered = append(ordered, names[v])
+	}
+	return ordered
+}
+
+// HelperNames returns the names of the functions generated for the enum,
+// besides its methods.
+func (e *EnumDefinition) HelperNames() []string {
+	return []string{e.TypeName + "Values", "Parse" + e.TypeName}
+}
+
 type Constants struct {
 	// SecuritySchemeProviderNames holds all provider names for security schemes.
 	SecuritySchemeProviderNames []string
@@ -188,6 +276,9 @@ type TypeDefinition struct {
 
 	// This is the Schema wrapper is used to populate the type description
 	Schema Schema
+
+	// generator is the Generator which created the type definition, if any
+	generator *Generator
 }
 
 // ResponseTypeDefinition is an extension of TypeDefinition, specifically for
@@ -204,7 +295,7 @@ type ResponseTypeDefinition struct {
 }
 
 func (t *TypeDefinition) IsAlias() bool {
-	return !globalState.options.Compatibility.OldAliasing && t.Schema.DefineViaAlias
+	return !t.generator.orDefault().opts.Compatibility.OldAliasing && t.Schema.DefineViaAlias
 }
 
 type Discriminator struct {
@@ -213,6 +304,9 @@ type Discriminator struct {
 
 	// JSON property name that holds the discriminator
 	Property string
+
+	// generator is the Generator which created the discriminator, if any
+	generator *Generator
 }
 
 func (d *Discriminator) JSONTag() string {
@@ -220,7 +314,7 @@ func (d *Discriminator) JSONTag() string {
 }
 
 func (d *Discriminator) PropertyName() string {
-	return SchemaNameToTypeName(d.Property)
+	return d.generator.orDefault().SchemaNameToTypeName(d.Property)
 }
 
 // UnionElement describe union element, based on prefix externalRef\d+ and real ref name from external schema.
@@ -244,7 +338,14 @@ func PropertiesEqual(a, b Property) bool {
 	return a.JsonFieldName == b.JsonFieldName && a.Schema.TypeDecl() == b.Schema.TypeDecl() && a.Required == b.Required
 }
 
+// GenerateGoSchema calls Generator.GenerateGoSchema using the default Configuration.
 func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
+	return defaultGenerator.GenerateGoSchema(sref, path)
+}
+
+// GenerateGoSchema converts an OpenAPI schema into a Schema describing the Go
+// type which represents it, where path is used to name any auxiliary types.
+func (g *Generator) GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 	// Add a fallback value in case the sref is nil.
 	// i.e. the parent schema defines a type:array, but the array has
 	// no items defined. Therefore, we have at least valid Go-Code.
@@ -258,14 +359,15 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 	// another type. We're not de-referencing, so simply use the referenced type.
 	if IsGoTypeReference(sref.Ref) {
 		// Convert the reference path to Go type
-		refType, err := RefPathToGoType(sref.Ref)
+		refType, err := g.RefPathToGoType(sref.Ref)
 		if err != nil {
 			return Schema{}, fmt.Errorf("error turning reference (%s) into a Go type: %s",
 				sref.Ref, err)
 		}
 		return Schema{
-			GoType:         refType,
+			GoType:         g.variantGoType(sref.Ref, refType),
 			Description:    schema.Description,
+			SealedUnion:    g.sealsUnion(schema),
 			DefineViaAlias: true,
 			OAPISchema:     schema,
 		}, nil
@@ -281,7 +383,7 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 	// so that in a RESTful paradigm, the Create operation can return
 	// (object, id), so that other operations can refer to (id)
 	if schema.AllOf != nil {
-		mergedSchema, err := MergeSchemas(schema.AllOf, path)
+		mergedSchema, err := g.MergeSchemas(schema.AllOf, path)
 		if err != nil {
 			return Schema{}, fmt.Errorf("error merging schemas: %w", err)
 		}
@@ -350,7 +452,7 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 			// If additional properties are defined, we will override the default
 			// above with the specific definition.
 			if schema.AdditionalProperties.Schema != nil {
-				additionalSchema, err := GenerateGoSchema(schema.AdditionalProperties.Schema, path)
+				additionalSchema, err := g.GenerateGoSchema(schema.AdditionalProperties.Schema, path)
 				if err != nil {
 					return Schema{}, fmt.Errorf("error generating type for additional properties: %w", err)
 				}
@@ -359,12 +461,13 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 					// but are not a pre-defined type, we need to define a type
 					// for them, which will be based on the field names we followed
 					// to get to the type.
-					typeName := PathToTypeName(append(path, "AdditionalProperties"))
+					typeName := g.PathToTypeName(g.variantPath(append(path, "AdditionalProperties"), schema.AdditionalProperties.Schema))
 
 					typeDef := TypeDefinition{
-						TypeName: typeName,
-						JsonName: strings.Join(append(path, "AdditionalProperties"), "."),
-						Schema:   additionalSchema,
+						TypeName:  typeName,
+						JsonName:  strings.Join(append(path, "AdditionalProperties"), "."),
+						Schema:    additionalSchema,
+						generator: g,
 					}
 					additionalSchema.RefType = typeName
 					additionalSchema.AdditionalTypes = append(additionalSchema.AdditionalTypes, typeDef)
@@ -377,7 +480,7 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 			// early-out here and generate a map[string]<schema> instead of an object
 			// that contains this map. We skip over anyOf/oneOf here because they can
 			// introduce properties. allOf was handled above.
-			if !globalState.options.Compatibility.DisableFlattenAdditionalProperties &&
+			if !g.opts.Compatibility.DisableFlattenAdditionalProperties &&
 				len(schema.Properties) == 0 && schema.AnyOf == nil && schema.OneOf == nil {
 				// We have a dictionary here. Returns the goType to be just a map from
 				// string to the property type. HasAdditionalProperties=false means
@@ -391,8 +494,11 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 			// We've got an object with some properties.
 			for _, pName := range SortedSchemaKeys(schema.Properties) {
 				p := schema.Properties[pName]
+				if p.Value != nil && g.skipsProperty(p.Value) {
+					continue
+				}
 				propertyPath := append(path, pName)
-				pSchema, err := GenerateGoSchema(p, propertyPath)
+				pSchema, err := g.GenerateGoSchema(p, propertyPath)
 				if err != nil {
 					return Schema{}, fmt.Errorf("error generating Go schema for property '%s': %w", pName, err)
 				}
@@ -404,12 +510,13 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 					// but are not a pre-defined type, we need to define a type
 					// for them, which will be based on the field names we followed
 					// to get to the type.
-					typeName := PathToTypeName(propertyPath)
+					typeName := g.PathToTypeName(g.variantPath(propertyPath, p))
 
 					typeDef := TypeDefinition{
-						TypeName: typeName,
-						JsonName: strings.Join(propertyPath, "."),
-						Schema:   pSchema,
+						TypeName:  typeName,
+						JsonName:  strings.Join(propertyPath, "."),
+						Schema:    pSchema,
+						generator: g,
 					}
 					pSchema.AdditionalTypes = append(pSchema.AdditionalTypes, typeDef)
 
@@ -419,16 +526,24 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 				if p.Value != nil {
 					description = p.Value.Description
 				}
+				representation, err := g.fieldRepresentation(schema, p)
+				if err != nil {
+					return Schema{}, fmt.Errorf("error generating Go schema for property '%s': %w", pName, err)
+				}
+				// The properties of the variants are both read and written
 				prop := Property{
 					JsonFieldName: pName,
 					Schema:        pSchema,
 					Required:      required,
 					Description:   description,
 					Nullable:      p.Value.Nullable,
-					ReadOnly:      p.Value.ReadOnly,
-					WriteOnly:     p.Value.WriteOnly,
+					ReadOnly:      p.Value.ReadOnly && g.variant == "",
+					WriteOnly:     p.Value.WriteOnly && g.variant == "",
 					Extensions:    p.Value.Extensions,
 					Deprecated:    p.Value.Deprecated,
+
+					FieldRepresentation: representation,
+					generator:           g,
 				}
 				outSchema.Properties = append(outSchema.Properties, prop)
 				if len(pSchema.AdditionalTypes) > 0 {
@@ -437,17 +552,17 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 			}
 
 			if schema.AnyOf != nil {
-				if err := generateUnion(&outSchema, schema.AnyOf, schema.Discriminator, path); err != nil {
+				if err := g.generateUnion(&outSchema, schema.AnyOf, schema.Discriminator, path); err != nil {
 					return Schema{}, fmt.Errorf("error generating type for anyOf: %w", err)
 				}
 			}
 			if schema.OneOf != nil {
-				if err := generateUnion(&outSchema, schema.OneOf, schema.Discriminator, path); err != nil {
+				if err := g.generateUnion(&outSchema, schema.OneOf, schema.Discriminator, path); err != nil {
 					return Schema{}, fmt.Errorf("error generating type for oneOf: %w", err)
 				}
 			}
 
-			outSchema.GoType = GenStructFromSchema(outSchema)
+			outSchema.GoType = g.GenStructFromSchema(outSchema)
 		}
 
 		// Check for x-go-type-name. It behaves much like x-go-type, however, it will
@@ -460,8 +575,9 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 			}
 
 			newTypeDef := TypeDefinition{
-				TypeName: typeName,
-				Schema:   outSchema,
+				TypeName:  typeName,
+				Schema:    outSchema,
+				generator: g,
 			}
 			outSchema = Schema{
 				Description:     newTypeDef.Schema.Description,
@@ -473,7 +589,7 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 
 		return outSchema, nil
 	} else if len(schema.Enum) > 0 {
-		err := oapiSchemaToGoType(schema, path, &outSchema)
+		err := g.oapiSchemaToGoType(schema, path, &outSchema)
 		// Enums need to be typed, so that the values aren't interchangeable,
 		// so no matter what schema conversion thinks, we need to define a
 		// new type.
@@ -497,7 +613,7 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 			}
 		}
 
-		sanitizedValues := SanitizeEnumNames(enumNames, enumValues)
+		sanitizedValues := g.SanitizeEnumNames(enumNames, enumValues)
 		outSchema.EnumValues = make(map[string]string, len(sanitizedValues))
 
 		for k, v := range sanitizedValues {
@@ -507,10 +623,10 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 			} else {
 				enumName = k
 			}
-			if globalState.options.Compatibility.OldEnumConflicts {
-				outSchema.EnumValues[SchemaNameToTypeName(PathToTypeName(append(path, enumName)))] = v
+			if g.opts.Compatibility.OldEnumConflicts {
+				outSchema.EnumValues[g.SchemaNameToTypeName(g.PathToTypeName(append(path, enumName)))] = v
 			} else {
-				outSchema.EnumValues[SchemaNameToTypeName(k)] = v
+				outSchema.EnumValues[g.SchemaNameToTypeName(k)] = v
 			}
 		}
 		if len(path) > 1 { // handle additional type only on non-toplevel types
@@ -523,19 +639,20 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 					return outSchema, fmt.Errorf("invalid value for %q: %w", extGoTypeName, err)
 				}
 			} else {
-				typeName = SchemaNameToTypeName(PathToTypeName(path))
+				typeName = g.SchemaNameToTypeName(g.PathToTypeName(path))
 			}
 
 			typeDef := TypeDefinition{
-				TypeName: typeName,
-				JsonName: strings.Join(path, "."),
-				Schema:   outSchema,
+				TypeName:  typeName,
+				JsonName:  strings.Join(path, "."),
+				Schema:    outSchema,
+				generator: g,
 			}
 			outSchema.AdditionalTypes = append(outSchema.AdditionalTypes, typeDef)
 			outSchema.RefType = typeName
 		}
 	} else {
-		err := oapiSchemaToGoType(schema, path, &outSchema)
+		err := g.oapiSchemaToGoType(schema, path, &outSchema)
 		if err != nil {
 			return Schema{}, fmt.Errorf("error resolving primitive type: %w", err)
 		}
@@ -545,14 +662,22 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 
 // oapiSchemaToGoType converts an OpenApi schema into a Go type definition for
 // all non-object types.
-func oapiSchemaToGoType(schema *openapi3.Schema, path []string, outSchema *Schema) error {
+func (g *Generator) oapiSchemaToGoType(schema *openapi3.Schema, path []string, outSchema *Schema) error {
 	f := schema.Format
 	t := schema.Type
 
+	// A mapping configured for the type and format takes precedence over the
+	// built-in ones below.
+	if target, ok := g.opts.TypeMapping.lookup(t, f); ok {
+		outSchema.GoType = target.GoType
+		outSchema.DefineViaAlias = true
+		return nil
+	}
+
 	if t.Is("array") {
 		// For arrays, we'll get the type of the Items and throw a
 		// [] in front of it.
-		arrayType, err := GenerateGoSchema(schema.Items, path)
+		arrayType, err := g.GenerateGoSchema(schema.Items, path)
 		if err != nil {
 			return fmt.Errorf("error generating type for array: %w", err)
 		}
@@ -561,12 +686,13 @@ func oapiSchemaToGoType(schema *openapi3.Schema, path []string, outSchema *Schem
 			// but are not a pre-defined type, we need to define a type
 			// for them, which will be based on the field names we followed
 			// to get to the type.
-			typeName := PathToTypeName(append(path, "Item"))
+			typeName := g.PathToTypeName(g.variantPath(append(path, "Item"), schema.Items))
 
 			typeDef := TypeDefinition{
-				TypeName: typeName,
-				JsonName: strings.Join(append(path, "Item"), "."),
-				Schema:   arrayType,
+				TypeName:  typeName,
+				JsonName:  strings.Join(append(path, "Item"), "."),
+				Schema:    arrayType,
+				generator: g,
 			}
 			arrayType.AdditionalTypes = append(arrayType.AdditionalTypes, typeDef)
 
@@ -577,7 +703,7 @@ func oapiSchemaToGoType(schema *openapi3.Schema, path []string, outSchema *Schem
 		outSchema.AdditionalTypes = arrayType.AdditionalTypes
 		outSchema.Properties = arrayType.Properties
 		outSchema.DefineViaAlias = true
-		if sliceContains(globalState.options.OutputOptions.DisableTypeAliasesForType, "array") {
+		if sliceContains(g.opts.OutputOptions.DisableTypeAliasesForType, "array") {
 			outSchema.DefineViaAlias = false
 		}
 
@@ -646,6 +772,11 @@ func oapiSchemaToGoType(schema *openapi3.Schema, path []string, outSchema *Schem
 			outSchema.GoType = "string"
 		}
 		outSchema.DefineViaAlias = true
+	} else if len(t.Slice()) > 1 {
+		// OpenAPI 3.1 allows a value to be one of several types, which we
+		// can't represent with a single Go type.
+		outSchema.GoType = "interface{}"
+		outSchema.DefineViaAlias = true
 	} else {
 		return fmt.Errorf("unhandled Schema type: %v", t)
 	}
@@ -669,7 +800,16 @@ type FieldDescriptor struct {
 
 // GenFieldsFromProperties produce corresponding field names with JSON annotations,
 // given a list of schema descriptors
+//
+// It uses the default Configuration; see Generator.GenFieldsFromProperties.
 func GenFieldsFromProperties(props []Property) []string {
+	return defaultGenerator.GenFieldsFromProperties(props)
+}
+
+// GenFieldsFromProperties produce corresponding field names with JSON
+// annotations, given a list of schema descriptors, using the Generator's
+// Configuration.
+func (g *Generator) GenFieldsFromProperties(props []Property) []string {
 	var fields []string
 	for i, p := range props {
 		field := ""
@@ -709,11 +849,11 @@ func GenFieldsFromProperties(props []Property) []string {
 		field += fmt.Sprintf("    %s %s", goFieldName, p.GoTypeDef())
 
 		shouldOmitEmpty := (!p.Required || p.ReadOnly || p.WriteOnly) &&
-			(!p.Required || !p.ReadOnly || !globalState.options.Compatibility.DisableRequiredReadOnlyAsPointer)
+			(!p.Required || !p.ReadOnly || !g.opts.Compatibility.DisableRequiredReadOnlyAsPointer)
 
 		omitEmpty := !p.Nullable && shouldOmitEmpty
 
-		if p.Nullable && globalState.options.OutputOptions.NullableType {
+		if (p.Nullable && g.opts.OutputOptions.NullableType) || p.isOptional() {
 			omitEmpty = shouldOmitEmpty
 		}
 
@@ -777,11 +917,17 @@ func additionalPropertiesType(schema Schema) string {
 	return addPropsType
 }
 
+// GenStructFromSchema calls Generator.GenStructFromSchema using the default Configuration.
 func GenStructFromSchema(schema Schema) string {
+	return defaultGenerator.GenStructFromSchema(schema)
+}
+
+// GenStructFromSchema generates the Go struct type for an object schema.
+func (g *Generator) GenStructFromSchema(schema Schema) string {
 	// Start out with struct {
 	objectParts := []string{"struct {"}
 	// Append all the field definitions
-	objectParts = append(objectParts, GenFieldsFromProperties(schema.Properties)...)
+	objectParts = append(objectParts, g.GenFieldsFromProperties(schema.Properties)...)
 	// Close the struct
 	if schema.HasAdditionalProperties {
 		objectParts = append(objectParts,
@@ -797,14 +943,14 @@ func GenStructFromSchema(schema Schema) string {
 
 // This constructs a Go type for a parameter, looking at either the schema or
 // the content, whichever is available
-func paramToGoType(param *openapi3.Parameter, path []string) (Schema, error) {
+func (g *Generator) paramToGoType(param *openapi3.Parameter, path []string) (Schema, error) {
 	if param.Content == nil && param.Schema == nil {
 		return Schema{}, fmt.Errorf("parameter '%s' has no schema or content", param.Name)
 	}
 
 	// We can process the schema through the generic schema processor
 	if param.Schema != nil {
-		return GenerateGoSchema(param.Schema, path)
+		return g.GenerateGoSchema(param.Schema, path)
 	}
 
 	// At this point, we have a content type. We know how to deal with
@@ -828,31 +974,32 @@ func paramToGoType(param *openapi3.Parameter, path []string) (Schema, error) {
 	}
 
 	// For json, we go through the standard schema mechanism
-	return GenerateGoSchema(mt.Schema, path)
+	return g.GenerateGoSchema(mt.Schema, path)
 }
 
-func generateUnion(outSchema *Schema, elements openapi3.SchemaRefs, discriminator *openapi3.Discriminator, path []string) error {
+func (g *Generator) generateUnion(outSchema *Schema, elements openapi3.SchemaRefs, discriminator *openapi3.Discriminator, path []string) error {
 	if discriminator != nil {
 		outSchema.Discriminator = &Discriminator{
-			Property: discriminator.PropertyName,
-			Mapping:  make(map[string]string),
+			Property:  discriminator.PropertyName,
+			Mapping:   make(map[string]string),
+			generator: g,
 		}
 	}
 
 	refToGoTypeMap := make(map[string]string)
 	for i, element := range elements {
 		elementPath := append(path, fmt.Sprint(i))
-		elementSchema, err := GenerateGoSchema(element, elementPath)
+		elementSchema, err := g.GenerateGoSchema(element, elementPath)
 		if err != nil {
 			return err
 		}
 
 		if element.Ref == "" {
-			elementName := SchemaNameToTypeName(PathToTypeName(elementPath))
+			elementName := g.SchemaNameToTypeName(g.PathToTypeName(g.variantPath(elementPath, element)))
 			if elementSchema.TypeDecl() == elementName {
 				elementSchema.GoType = elementName
 			} else {
-				td := TypeDefinition{Schema: elementSchema, TypeName: elementName}
+				td := TypeDefinition{Schema: elementSchema, TypeName: elementName, generator: g}
 				outSchema.AdditionalTypes = append(outSchema.AdditionalTypes, td)
 				elementSchema.GoType = td.TypeName
 			}
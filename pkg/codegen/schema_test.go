@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestProperty_GoTypeDef(t *testing.T) {
@@ -202,13 +203,17 @@ func TestProperty_GoTypeDef(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			globalState.options.Compatibility.DisableRequiredReadOnlyAsPointer = tt.fields.GlobalStateDisableRequiredReadOnlyAsPointer
+			var opts Configuration
+			opts.Compatibility.DisableRequiredReadOnlyAsPointer = tt.fields.GlobalStateDisableRequiredReadOnlyAsPointer
+			g, err := NewGenerator(nil, opts)
+			require.NoError(t, err)
 			p := Property{
 				Schema:    tt.fields.Schema,
 				Required:  tt.fields.Required,
 				Nullable:  tt.fields.Nullable,
 				ReadOnly:  tt.fields.ReadOnly,
 				WriteOnly: tt.fields.WriteOnly,
+				generator: g,
 			}
 			assert.Equal(t, tt.want, p.GoTypeDef())
 		})
@@ -441,14 +446,18 @@ func TestProperty_GoTypeDef_nullable(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			globalState.options.Compatibility.DisableRequiredReadOnlyAsPointer = tt.fields.GlobalStateDisableRequiredReadOnlyAsPointer
-			globalState.options.OutputOptions.NullableType = tt.fields.GlobalStateNullableType
+			var opts Configuration
+			opts.Compatibility.DisableRequiredReadOnlyAsPointer = tt.fields.GlobalStateDisableRequiredReadOnlyAsPointer
+			opts.OutputOptions.NullableType = tt.fields.GlobalStateNullableType
+			g, err := NewGenerator(nil, opts)
+			require.NoError(t, err)
 			p := Property{
 				Schema:    tt.fields.Schema,
 				Required:  tt.fields.Required,
 				Nullable:  tt.fields.Nullable,
 				ReadOnly:  tt.fields.ReadOnly,
 				WriteOnly: tt.fields.WriteOnly,
+				generator: g,
 			}
 			assert.Equal(t, tt.want, p.GoTypeDef())
 		})
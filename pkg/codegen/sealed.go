@@ -0,0 +1,142 @@
+package codegen
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// SealedUnionDefinition describes a oneOf union with a discriminator which is
+// represented by a sealed interface, implemented by each of its variants.
+type SealedUnionDefinition struct {
+	// TypeName is the name of the type holding the union's value
+	TypeName string
+	// Discriminator describes the property which tells the variants apart
+	Discriminator *Discriminator
+	// Variants are the types the union may hold, in the order of the oneOf
+	Variants []SealedUnionVariant
+}
+
+// InterfaceName is the name of the sealed interface of the union.
+func (d SealedUnionDefinition) InterfaceName() string {
+	return d.TypeName + "Variant"
+}
+
+// VisitorName is the name of the interface handling each of the variants of
+// the union.
+func (d SealedUnionDefinition) VisitorName() string {
+	return d.TypeName + "Visitor"
+}
+
+// MarkerMethod is the name of the unexported method which seals the interface.
+func (d SealedUnionDefinition) MarkerMethod() string {
+	return "is" + d.InterfaceName()
+}
+
+// SealedUnionVariant is one of the types a sealed union may hold.
+type SealedUnionVariant struct {
+	// Type is the Go type of the variant
+	Type UnionElement
+	// Value is the value of the discriminator for the variant
+	Value string
+	// SetsDiscriminator is whether the discriminator property of the variant
+	// is set to Value when marshaling, which is the case when it's required.
+	SetsDiscriminator bool
+}
+
+// sealsUnion returns whether the union of the schema is represented by a sealed
+// interface. These are the oneOf unions with a discriminator and no properties
+// of their own, whose elements all refer to distinct component schemas, so
+// the methods sealing the interface can be declared on their types.
+func (g *Generator) sealsUnion(schema *openapi3.Schema) bool {
+	if !g.opts.OutputOptions.SealedUnions || schema == nil {
+		return false
+	}
+	if len(schema.OneOf) == 0 || schema.Discriminator == nil || len(schema.AnyOf) != 0 || len(schema.AllOf) != 0 ||
+		len(schema.Properties) != 0 || schema.AdditionalProperties.Schema != nil ||
+		(schema.AdditionalProperties.Has != nil && *schema.AdditionalProperties.Has) {
+		return false
+	}
+	refs := map[string]bool{}
+	for _, element := range schema.OneOf {
+		if !strings.HasPrefix(element.Ref, componentSchemasPrefix) || refs[element.Ref] || element.Value == nil {
+			return false
+		}
+		if _, ok := element.Value.Extensions[extPropGoType]; ok {
+			return false
+		}
+		refs[element.Ref] = true
+	}
+	return true
+}
+
+// sealUnions represents the unions among the types which are sealed with
+// their sealed interfaces, and returns their definitions.
+func (g *Generator) sealUnions(types []TypeDefinition) ([]SealedUnionDefinition, error) {
+	typeNames := map[string]bool{}
+	for _, td := range types {
+		typeNames[td.TypeName] = true
+	}
+
+	var unions []SealedUnionDefinition
+	sealed := map[string]bool{}
+	for i, td := range types {
+		schema := td.Schema
+		if len(schema.UnionElements) == 0 || schema.Discriminator == nil || schema.IsRef() || !g.sealsUnion(schema.OAPISchema) {
+			continue
+		}
+		union := SealedUnionDefinition{
+			TypeName:      td.TypeName,
+			Discriminator: schema.Discriminator,
+		}
+		types[i].Schema.GoType = fmt.Sprintf("struct {\nValue %s\n}", union.InterfaceName())
+		types[i].Schema.SealedUnion = true
+		if sealed[td.TypeName] {
+			continue
+		}
+		sealed[td.TypeName] = true
+
+		for _, name := range []string{union.InterfaceName(), union.VisitorName()} {
+			if typeNames[name] {
+				return nil, fmt.Errorf("the %s interface of the union %s conflicts with a type of the same name", name, td.TypeName)
+			}
+		}
+
+		for j, element := range schema.UnionElements {
+			variant := SealedUnionVariant{Type: element}
+			for _, value := range SortedMapKeys(schema.Discriminator.Mapping) {
+				if schema.Discriminator.Mapping[value] == element.String() {
+					variant.Value = value
+					break
+				}
+			}
+			elementSchema := schema.OAPISchema.OneOf[j].Value
+			if p, ok := elementSchema.Properties[schema.Discriminator.Property]; ok && p.Value != nil &&
+				!p.Value.Nullable && !p.Value.ReadOnly && !p.Value.WriteOnly {
+				variant.SetsDiscriminator = StringInArray(schema.Discriminator.Property, elementSchema.Required)
+			}
+			union.Variants = append(union.Variants, variant)
+		}
+
+		unions = append(unions, union)
+	}
+	return unions, nil
+}
+
+// GenerateSealedUnions generates the sealed interfaces of the unions, along
+// with their JSON marshaling and visitors.
+func GenerateSealedUnions(t *template.Template, unions []SealedUnionDefinition) (string, error) {
+	if len(unions) == 0 {
+		return "", nil
+	}
+
+	context := struct {
+		Unions []SealedUnionDefinition
+	}{
+		Unions: unions,
+	}
+
+	return GenerateTemplates([]string{"sealed-union.tmpl"}, t, context)
+}
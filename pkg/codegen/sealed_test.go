@@ -0,0 +1,147 @@
+package codegen
+
+import (
+	"go/format"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const sealedUnionsSpec = `
+openapi: "3.0.0"
+info:
+  version: 1.0.0
+  title: Sealed unions
+paths:
+  /pets:
+    get:
+      operationId: getPet
+      responses:
+        '200':
+          description: A pet
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/Pet'
+components:
+  schemas:
+    Pet:
+      oneOf:
+        - $ref: '#/components/schemas/Cat'
+        - $ref: '#/components/schemas/Dog'
+      discriminator:
+        propertyName: petType
+        mapping:
+          cat: '#/components/schemas/Cat'
+          dog: '#/components/schemas/Dog'
+    Animal:
+      oneOf:
+        - $ref: '#/components/schemas/Cat'
+        - type: object
+          properties:
+            petType:
+              type: string
+      discriminator:
+        propertyName: petType
+    Cat:
+      type: object
+      required: [petType]
+      properties:
+        petType:
+          type: string
+    Dog:
+      type: object
+      properties:
+        petType:
+          type: string
+`
+
+func TestGenerateSealedUnions(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(sealedUnionsSpec))
+	require.NoError(t, err)
+
+	code, err := Generate(swagger, Configuration{
+		PackageName:   "api",
+		Generate:      GenerateOptions{Models: true, StdHTTPServer: true, Strict: true},
+		OutputOptions: OutputOptions{SkipPrune: true, SealedUnions: true},
+	})
+	require.NoError(t, err)
+	_, err = format.Source([]byte(code))
+	require.NoError(t, err)
+
+	assert.Contains(t, code, "type Pet struct {\n\tValue PetVariant\n}")
+	assert.Contains(t, code, "type PetVariant interface {\n\tisPetVariant()\n}")
+	assert.Contains(t, code, "func (Cat) isPetVariant() {}")
+	assert.Contains(t, code, "type PetVisitor interface {\n\tVisitCat(Cat) error\n\tVisitDog(Dog) error\n}")
+	assert.Contains(t, code, "func (t Pet) Visit(visitor PetVisitor) error {")
+	assert.Contains(t, code, "func (t *Pet) UnmarshalJSON(b []byte) error {")
+	assert.NotContains(t, code, "func (t Pet) AsCat()")
+
+	// The discriminator is only set when the variant requires it
+	assert.Contains(t, code, "\tcase Cat:\n\t\tv.PetType = \"cat\"\n\t\treturn json.Marshal(v)\n")
+	assert.Contains(t, code, "\tcase Dog:\n\t\treturn json.Marshal(v)\n")
+
+	// The strict responses are marshaled as the union
+	assert.Contains(t, code, "Encode(Pet(response))")
+
+	// Unions with inline schemas keep their accessors
+	assert.Contains(t, code, "union json.RawMessage")
+	assert.Contains(t, code, "func (t Animal) AsCat()")
+}
+
+func TestGenerateSealedUnionsInlineOperation(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(sealedUnionsSpec))
+	require.NoError(t, err)
+	pet := swagger.Components.Schemas["Pet"].Value
+	swagger.Paths.Value("/pets").Post = &openapi3.Operation{
+		OperationID: "addPet",
+		RequestBody: &openapi3.RequestBodyRef{Value: openapi3.NewRequestBody().WithJSONSchema(&openapi3.Schema{
+			OneOf:         pet.OneOf,
+			Discriminator: pet.Discriminator,
+		})},
+		Responses: openapi3.NewResponses(),
+	}
+
+	code, err := Generate(swagger, Configuration{
+		PackageName:   "api",
+		Generate:      GenerateOptions{Models: true},
+		OutputOptions: OutputOptions{SkipPrune: true, SealedUnions: true},
+	})
+	require.NoError(t, err)
+
+	// Only the unions defined in the components are sealed
+	assert.Contains(t, code, "type Pet struct {\n\tValue PetVariant\n}")
+	assert.Contains(t, code, "type AddPetJSONBody struct {\n\tunion json.RawMessage\n}")
+	assert.NotContains(t, code, "AddPetJSONBodyVariant")
+}
+
+func TestGenerateSealedUnionsDisabled(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(sealedUnionsSpec))
+	require.NoError(t, err)
+
+	code, err := Generate(swagger, Configuration{
+		PackageName:   "api",
+		Generate:      GenerateOptions{Models: true},
+		OutputOptions: OutputOptions{SkipPrune: true},
+	})
+	require.NoError(t, err)
+
+	assert.Contains(t, code, "func (t Pet) AsCat()")
+	assert.NotContains(t, code, "PetVariant")
+}
+
+func TestSealedUnionConflicts(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(sealedUnionsSpec))
+	require.NoError(t, err)
+	swagger.Components.Schemas["PetVisitor"] = openapi3.NewSchemaRef("", openapi3.NewStringSchema())
+
+	_, err = Generate(swagger, Configuration{
+		PackageName:   "api",
+		Generate:      GenerateOptions{Models: true},
+		OutputOptions: OutputOptions{SkipPrune: true, SealedUnions: true},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "the PetVisitor interface of the union Pet conflicts with a type of the same name")
+}
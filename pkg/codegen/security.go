@@ -0,0 +1,150 @@
+package codegen
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// SecuritySchemeDefinition describes one of the security schemes of the spec,
+// which the generated SecurityHandler has a method to authenticate.
+type SecuritySchemeDefinition struct {
+	Name        string // The name of the scheme in components/securitySchemes
+	GoName      string // The name of the scheme in Go, as in the method Handle<GoName>
+	Type        string // apiKey, http, oauth2 or openIdConnect
+	Scheme      string // The lowercased HTTP authorization scheme of http schemes, such as bearer
+	In          string // Where the key of apiKey schemes is passed: header, query or cookie
+	ParamName   string // The name of the header, query parameter or cookie of apiKey schemes
+	Description string
+}
+
+// IsAPIKey returns whether the credentials of the scheme are an API key.
+func (s SecuritySchemeDefinition) IsAPIKey() bool {
+	return s.Type == "apiKey"
+}
+
+// IsBasic returns whether the credentials of the scheme are a username and
+// password, passed with HTTP basic authentication.
+func (s SecuritySchemeDefinition) IsBasic() bool {
+	return s.Type == "http" && s.Scheme == "basic"
+}
+
+// AuthorizationScheme returns the scheme of the Authorization header which
+// carries the credentials of the scheme, which is Bearer for the access tokens
+// of OAuth 2 and OpenID Connect, or "" for API keys.
+func (s SecuritySchemeDefinition) AuthorizationScheme() string {
+	switch s.Type {
+	case "http":
+		return UppercaseFirstCharacter(s.Scheme)
+	case "oauth2", "openIdConnect":
+		return "Bearer"
+	}
+	return ""
+}
+
+// CredentialParams returns the parameters the credentials of the scheme are
+// passed to its method of the SecurityHandler as.
+func (s SecuritySchemeDefinition) CredentialParams() string {
+	switch {
+	case s.IsAPIKey():
+		return "key string"
+	case s.IsBasic():
+		return "username, password string"
+	case s.AuthorizationScheme() == "Bearer":
+		return "token string"
+	}
+	return "credentials string"
+}
+
+// CredentialArgs returns the parameters of CredentialParams as the arguments
+// of a call.
+func (s SecuritySchemeDefinition) CredentialArgs() string {
+	return strings.TrimSuffix(s.CredentialParams(), " string")
+}
+
+// SecurityHandlerDefinition is what the SecurityHandler is generated from.
+type SecurityHandlerDefinition struct {
+	Schemes    []SecuritySchemeDefinition
+	Operations []OperationDefinition
+}
+
+// HasAuthorization returns whether any of the schemes are passed in the
+// Authorization header.
+func (d SecurityHandlerDefinition) HasAuthorization() bool {
+	for _, s := range d.Schemes {
+		if !s.IsAPIKey() {
+			return true
+		}
+	}
+	return false
+}
+
+// HasBasic returns whether any of the schemes use HTTP basic authentication.
+func (d SecurityHandlerDefinition) HasBasic() bool {
+	for _, s := range d.Schemes {
+		if s.IsBasic() {
+			return true
+		}
+	}
+	return false
+}
+
+// SecuritySchemeDefinitions returns the definitions of the security schemes
+// of the spec, in order of their names.
+func (g *Generator) SecuritySchemeDefinitions(spec *openapi3.T) ([]SecuritySchemeDefinition, error) {
+	if spec.Components == nil {
+		return nil, nil
+	}
+	var schemes []SecuritySchemeDefinition
+	for _, name := range SortedMapKeys(spec.Components.SecuritySchemes) {
+		ref := spec.Components.SecuritySchemes[name]
+		if ref == nil || ref.Value == nil {
+			continue
+		}
+		scheme := ref.Value
+		switch scheme.Type {
+		case "apiKey", "http", "oauth2", "openIdConnect":
+		default:
+			return nil, fmt.Errorf("security scheme %s of type %s isn't supported by the security handler", name, scheme.Type)
+		}
+		schemes = append(schemes, SecuritySchemeDefinition{
+			Name:        name,
+			GoName:      g.SchemaNameToTypeName(name),
+			Type:        scheme.Type,
+			Scheme:      strings.ToLower(scheme.Scheme),
+			In:          scheme.In,
+			ParamName:   scheme.Name,
+			Description: scheme.Description,
+		})
+	}
+	return schemes, nil
+}
+
+// GenerateSecurityHandler generates the SecurityHandler for the security
+// schemes of the spec, which authenticates the requests of the operations
+// before they're handled.
+func (g *Generator) GenerateSecurityHandler(t *template.Template, spec *openapi3.T, ops []OperationDefinition) (string, error) {
+	schemes, err := g.SecuritySchemeDefinitions(spec)
+	if err != nil {
+		return "", err
+	}
+
+	defined := make(map[string]bool, len(schemes))
+	for _, s := range schemes {
+		defined[s.Name] = true
+	}
+	for _, op := range ops {
+		for _, sd := range op.SecurityDefinitions {
+			if !defined[sd.ProviderName] {
+				return "", fmt.Errorf("operation %s requires the undefined security scheme %s", op.OperationId, sd.ProviderName)
+			}
+		}
+	}
+
+	return GenerateTemplates([]string{"security-handler.tmpl"}, t, SecurityHandlerDefinition{
+		Schemes:    schemes,
+		Operations: ops,
+	})
+}
@@ -0,0 +1,175 @@
+package codegen
+
+import (
+	"go/format"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const securitySpec = `
+openapi: "3.0.0"
+info:
+  version: 1.0.0
+  title: Security
+paths:
+  /public:
+    get:
+      operationId: getPublic
+      security: []
+      responses:
+        '204':
+          description: Public
+  /things/{id}:
+    get:
+      operationId: getThing
+      parameters:
+        - name: id
+          in: path
+          required: true
+          schema:
+            type: string
+      security:
+        - BearerAuth: [things:read]
+        - ApiKeyAuth: []
+          BasicAuth: []
+      responses:
+        '204':
+          description: Thing
+  /me:
+    get:
+      operationId: getMe
+      responses:
+        '204':
+          description: Me
+security:
+  - SessionAuth: []
+components:
+  securitySchemes:
+    ApiKeyAuth:
+      type: apiKey
+      in: header
+      name: X-API-Key
+    BasicAuth:
+      type: http
+      scheme: basic
+    BearerAuth:
+      type: http
+      scheme: bearer
+    SessionAuth:
+      type: apiKey
+      in: cookie
+      name: session
+`
+
+func TestGenerateSecurityHandler(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(securitySpec))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		generate GenerateOptions
+		contains []string
+	}{
+		{
+			name:     "chi",
+			generate: GenerateOptions{ChiServer: true},
+			contains: []string{
+				`authCtx, err := authenticate(ctx, siw.Handler, httpSecurityCredentials{r}, securityRequirements["GetThing"])`,
+				"func (_ Unimplemented) HandleBearerAuth(ctx context.Context, token string, scopes []string) (context.Context, error) {",
+			},
+		},
+		{
+			name:     "echo",
+			generate: GenerateOptions{EchoServer: true},
+			contains: []string{
+				`authCtx, err := authenticate(ctx.Request().Context(), w.Handler, httpSecurityCredentials{ctx.Request()}, securityRequirements["GetThing"])`,
+			},
+		},
+		{
+			name:     "fiber",
+			generate: GenerateOptions{FiberServer: true},
+			contains: []string{
+				`authCtx, err := authenticate(c.UserContext(), siw.Handler, fiberSecurityCredentials{c}, securityRequirements["GetThing"])`,
+				"c *fiber.Ctx",
+			},
+		},
+		{
+			name:     "strict",
+			generate: GenerateOptions{StdHTTPServer: true, Strict: true},
+			contains: []string{
+				"type StrictServerInterface interface {\n\tSecurityHandler\n",
+				"\treturn sh.ssi.HandleBasicAuth(ctx, username, password, scopes)\n",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			generate := tt.generate
+			generate.Models = true
+			generate.SecurityHandler = true
+
+			code, err := Generate(swagger, Configuration{PackageName: "api", Generate: generate})
+			require.NoError(t, err)
+			_, err = format.Source([]byte(code))
+			require.NoError(t, err)
+
+			assert.Contains(t, code, "type ServerInterface interface {\n\tSecurityHandler\n")
+			assert.Contains(t, code, "HandleApiKeyAuth(ctx context.Context, key string, scopes []string) (context.Context, error)")
+			assert.Contains(t, code, "HandleBasicAuth(ctx context.Context, username, password string, scopes []string) (context.Context, error)")
+			assert.Contains(t, code, "HandleBearerAuth(ctx context.Context, token string, scopes []string) (context.Context, error)")
+			assert.Contains(t, code, `key := creds.Cookie("session")`)
+			assert.Contains(t, code, "\t\"GetThing\": {\n"+
+				"\t\t{{\"BearerAuth\", []string{\"things:read\"}}},\n"+
+				"\t\t{{\"ApiKeyAuth\", []string{}}, {\"BasicAuth\", []string{}}},\n"+
+				"\t},\n")
+			assert.Contains(t, code, "\t\"GetMe\": {\n\t\t{{\"SessionAuth\", []string{}}},\n\t},\n")
+			assert.NotContains(t, code, `securityRequirements["GetPublic"]`)
+			for _, s := range tt.contains {
+				assert.Contains(t, code, s)
+			}
+		})
+	}
+}
+
+func TestGenerateSecurityHandlerMocks(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(securitySpec))
+	require.NoError(t, err)
+
+	files, err := GenerateFiles(swagger, Configuration{
+		PackageName: "api",
+		Generate: GenerateOptions{
+			Models:          true,
+			ChiServer:       true,
+			Strict:          true,
+			Mocks:           true,
+			SecurityHandler: true,
+		},
+	})
+	require.NoError(t, err)
+
+	code := files[MocksFileName]
+	assert.Contains(t, code, "func (m *MockServer) HandleBasicAuth(ctx context.Context, username string, password string, scopes []string) (context.Context, error) {")
+	assert.Contains(t, code, "func (m *MockStrictServer) HandleBearerAuth(ctx context.Context, token string, scopes []string) (context.Context, error) {")
+}
+
+func TestGenerateSecurityHandlerUndefinedScheme(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(securitySpec))
+	require.NoError(t, err)
+	delete(swagger.Components.SecuritySchemes, "SessionAuth")
+
+	_, err = Generate(swagger, Configuration{
+		PackageName: "api",
+		Generate:    GenerateOptions{ChiServer: true, SecurityHandler: true},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "operation GetMe requires the undefined security scheme SessionAuth")
+}
+
+func TestSecurityHandlerRequiresServer(t *testing.T) {
+	problems := GenerateOptions{Models: true, SecurityHandler: true}.Validate()
+	assert.Equal(t, map[string]string{"security-handler": "requires a server to be generated"}, problems)
+}
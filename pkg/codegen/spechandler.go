@@ -0,0 +1,73 @@
+package codegen
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"path"
+	"text/template"
+)
+
+// SpecDocsDir is the directory the assets of the docs page served by the
+// generated SpecHandler are written to, next to the generated code, which
+// embeds them from there.
+const SpecDocsDir = "openapi-docs"
+
+//go:embed specdocs
+var specDocs embed.FS
+
+// SpecDocsFiles returns the assets of the docs page served by the generated
+// SpecHandler, keyed by their paths relative to the directory of the generated
+// code, such as openapi-docs/index.html.
+func SpecDocsFiles() (map[string]string, error) {
+	files := make(map[string]string)
+	err := fs.WalkDir(specDocs, "specdocs", func(name string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		data, err := specDocs.ReadFile(name)
+		if err != nil {
+			return err
+		}
+		files[path.Join(SpecDocsDir, name[len("specdocs/"):])] = string(data)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error reading docs assets: %w", err)
+	}
+	return files, nil
+}
+
+// GenerateSpecHandler generates the handler serving the embedded spec and its
+// docs page, along with the function registering it on the router of the
+// generated server, if any.
+func GenerateSpecHandler(t *template.Template, opts Configuration) (string, error) {
+	templates := []string{"spec-handler/spec-handler.tmpl"}
+
+	switch {
+	case opts.Generate.ChiServer:
+		templates = append(templates, "spec-handler/spec-handler-chi.tmpl")
+	case opts.Generate.EchoServer:
+		templates = append(templates, "spec-handler/spec-handler-echo.tmpl")
+	case opts.Generate.FasthttpServer:
+		templates = append(templates, "spec-handler/spec-handler-fasthttp.tmpl")
+	case opts.Generate.FiberServer:
+		templates = append(templates, "spec-handler/spec-handler-fiber.tmpl")
+	case opts.Generate.FiberV3Server:
+		templates = append(templates, "spec-handler/spec-handler-fiber-v3.tmpl")
+	case opts.Generate.GinServer:
+		templates = append(templates, "spec-handler/spec-handler-gin.tmpl")
+	case opts.Generate.GorillaServer:
+		templates = append(templates, "spec-handler/spec-handler-gorilla.tmpl")
+	case opts.Generate.HertzServer:
+		templates = append(templates, "spec-handler/spec-handler-hertz.tmpl")
+	case opts.Generate.HttprouterServer:
+		templates = append(templates, "spec-handler/spec-handler-httprouter.tmpl")
+	case opts.Generate.IrisServer:
+		templates = append(templates, "spec-handler/spec-handler-iris.tmpl")
+	case opts.Generate.StdHTTPServer:
+		templates = append(templates, "spec-handler/spec-handler-std-http.tmpl")
+	}
+
+	return GenerateTemplates(templates, t, nil)
+}
@@ -0,0 +1,113 @@
+package codegen
+
+import (
+	"go/format"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateSpecHandler(t *testing.T) {
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+
+	swagger, err := loader.LoadFromData([]byte(testOpenAPIDefinition))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		generate GenerateOptions
+		contains []string
+	}{
+		{
+			name:     "no server",
+			generate: GenerateOptions{Models: true},
+			contains: []string{"func SpecHandler() http.Handler {"},
+		},
+		{
+			name:     "chi",
+			generate: GenerateOptions{ChiServer: true},
+			contains: []string{
+				"func RegisterSpecHandlersWithOptions(r chi.Router, options SpecHandlerOptions) {",
+				`r.Method(http.MethodGet, options.BaseURL+"/docs/*", h)`,
+			},
+		},
+		{
+			name:     "echo",
+			generate: GenerateOptions{EchoServer: true},
+			contains: []string{
+				"func RegisterSpecHandlersWithOptions(router EchoRouter, options SpecHandlerOptions) {",
+				"h := echo.WrapHandler(SpecHandlerWithOptions(options))",
+			},
+		},
+		{
+			name:     "gin",
+			generate: GenerateOptions{GinServer: true},
+			contains: []string{
+				"func RegisterSpecHandlersWithOptions(router gin.IRouter, options SpecHandlerOptions) {",
+				`router.GET(options.BaseURL+"/docs/*filepath", h)`,
+			},
+		},
+		{
+			name:     "fasthttp",
+			generate: GenerateOptions{FasthttpServer: true},
+			contains: []string{
+				"func RegisterSpecHandlersWithOptions(r *fasthttprouter.Router, options SpecHandlerOptions) {",
+				"h := fasthttpadaptor.NewFastHTTPHandler(SpecHandlerWithOptions(options))",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			generate := tt.generate
+			generate.EmbeddedSpec = true
+			generate.SpecHandler = true
+
+			code, err := Generate(swagger, Configuration{PackageName: "api", Generate: generate})
+			require.NoError(t, err)
+			_, err = format.Source([]byte(code))
+			require.NoError(t, err)
+
+			assert.Contains(t, code, "//go:embed openapi-docs\nvar specDocs embed.FS")
+			assert.Contains(t, code, `m.HandleFunc(options.BaseURL+"/openapi.yaml", func(w http.ResponseWriter, r *http.Request) {`)
+			for _, s := range tt.contains {
+				assert.Contains(t, code, s)
+			}
+		})
+	}
+}
+
+func TestGenerateFilesSpecHandler(t *testing.T) {
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+
+	swagger, err := loader.LoadFromData([]byte(testOpenAPIDefinition))
+	require.NoError(t, err)
+
+	files, err := GenerateFiles(swagger, Configuration{
+		PackageName: "api",
+		Generate: GenerateOptions{
+			StdHTTPServer: true,
+			EmbeddedSpec:  true,
+			SpecHandler:   true,
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Contains(t, files[SpecFileName], "func RegisterSpecHandlersWithOptions(m ServeMux, options SpecHandlerOptions) {")
+
+	docs, err := SpecDocsFiles()
+	require.NoError(t, err)
+	assert.Contains(t, docs, "openapi-docs/index.html")
+	for name, content := range docs {
+		assert.Equal(t, content, files[name], name)
+	}
+}
+
+func TestSpecHandlerRequiresEmbeddedSpec(t *testing.T) {
+	problems := GenerateOptions{ChiServer: true, SpecHandler: true}.Validate()
+	assert.Equal(t, map[string]string{"spec-handler": "requires `embedded-spec`, as the embedded spec is what's served"}, problems)
+}
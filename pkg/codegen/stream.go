@@ -0,0 +1,160 @@
+package codegen
+
+import (
+	"encoding/json"
+	"fmt"
+	"mime"
+	"strconv"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+const (
+	// keywordItemSchema is the schema of each item of a streamed media type,
+	// which kin-openapi leaves undecoded in the extensions of the media type
+	keywordItemSchema = "itemSchema"
+	// extStreamItem is the schema of each item of a streamed media type, for
+	// specs which can't use itemSchema
+	extStreamItem = "x-stream-item"
+)
+
+// Streamed media types, whose items are written and read one at a time
+const (
+	mediaTypeEventStream = "text/event-stream"
+	mediaTypeNDJSON      = "application/x-ndjson"
+)
+
+// isStreamMediaType returns whether contentType is a media type whose items
+// are streamed, that is Server-Sent Events or newline-delimited JSON.
+func isStreamMediaType(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	return mediaType == mediaTypeEventStream || mediaType == mediaTypeNDJSON
+}
+
+// streamItemSchema returns the schema of each item of a streamed media type,
+// or nil if it doesn't have one. It's only available once the spec has been
+// decoded by decodeStreamItemSchemas.
+func streamItemSchema(mediaType *openapi3.MediaType) *openapi3.SchemaRef {
+	if mediaType == nil {
+		return nil
+	}
+	for _, key := range []string{keywordItemSchema, extStreamItem} {
+		if sref, ok := mediaType.Extensions[key].(*openapi3.SchemaRef); ok {
+			return sref
+		}
+	}
+	return nil
+}
+
+// decodeStreamItemSchemas decodes the item schemas of the streamed media types
+// of the responses of a spec, and stores them back in the extensions of the
+// media types, which still serialize to the same JSON. References to component
+// schemas within them are resolved, so they're generated, and kept when
+// pruning, like any other schema.
+func decodeStreamItemSchemas(spec *openapi3.T) error {
+	var walkErr error
+	doFn := func(w RefWrapper) (bool, error) {
+		// Schemas never contain responses, and may be recursive
+		if _, ok := w.SourceRef.(*openapi3.SchemaRef); ok {
+			return false, nil
+		}
+		ref, ok := w.SourceRef.(*openapi3.ResponseRef)
+		if !ok || ref.Value == nil || walkErr != nil {
+			return true, nil
+		}
+		for _, contentType := range SortedMapKeys(ref.Value.Content) {
+			if err := decodeStreamItemSchema(spec, ref.Value.Content[contentType]); err != nil {
+				walkErr = fmt.Errorf("invalid item schema of %s: %w", contentType, err)
+				return false, nil
+			}
+		}
+		return true, nil
+	}
+	_ = walkSwagger(spec, doFn)
+	return walkErr
+}
+
+// decodeStreamItemSchema decodes the item schema of a single media type, if it
+// has one.
+func decodeStreamItemSchema(spec *openapi3.T, mediaType *openapi3.MediaType) error {
+	if mediaType == nil {
+		return nil
+	}
+	for _, key := range []string{keywordItemSchema, extStreamItem} {
+		raw, ok := mediaType.Extensions[key]
+		if !ok {
+			continue
+		}
+		if _, decoded := raw.(*openapi3.SchemaRef); decoded {
+			continue
+		}
+
+		data, err := json.Marshal(raw)
+		if err != nil {
+			return err
+		}
+		sref := &openapi3.SchemaRef{}
+		if err := sref.UnmarshalJSON(data); err != nil {
+			return err
+		}
+
+		// Only the inline schemas are descended into, as the component
+		// schemas have already been resolved.
+		var resolveErr error
+		_ = walkSchemaRef(sref, func(w RefWrapper) (bool, error) {
+			s := w.SourceRef.(*openapi3.SchemaRef)
+			if s.Ref == "" || s.Value != nil {
+				return s.Ref == "", nil
+			}
+			if spec.Components != nil {
+				if c := spec.Components.Schemas[componentName(s.Ref, "schemas")]; c != nil && c.Value != nil {
+					s.Value = c.Value
+					return false, nil
+				}
+			}
+			if resolveErr == nil {
+				resolveErr = fmt.Errorf("unresolved reference %s", s.Ref)
+			}
+			return false, nil
+		})
+		if resolveErr != nil {
+			return resolveErr
+		}
+		mediaType.Extensions[key] = sref
+	}
+	return nil
+}
+
+// ResponseStreamDefinition describes the response of an operation which is
+// streamed as a sequence of items.
+type ResponseStreamDefinition struct {
+	// StatusCode is the status code of the streamed response
+	StatusCode int
+	// Content is the streamed content of the response
+	Content ResponseContentDefinition
+}
+
+// ItemType returns the Go type of the items of the stream.
+func (s ResponseStreamDefinition) ItemType() string {
+	return s.Content.ItemSchema.TypeDecl()
+}
+
+// ResponseStream returns the first response of the operation with a fixed
+// status code, whose content is streamed, or nil if there isn't one.
+func (o *OperationDefinition) ResponseStream() *ResponseStreamDefinition {
+	for _, response := range o.Responses {
+		statusCode, err := strconv.Atoi(response.StatusCode)
+		if err != nil {
+			continue
+		}
+		for _, content := range response.Contents {
+			if content.IsStream() {
+				return &ResponseStreamDefinition{StatusCode: statusCode, Content: content}
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,164 @@
+package codegen
+
+import (
+	"go/format"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const streamSpec = `
+openapi: "3.0.0"
+info:
+  version: 1.0.0
+  title: Streams
+paths:
+  /events:
+    get:
+      operationId: streamEvents
+      responses:
+        '200':
+          description: Events as they happen
+          content:
+            text/event-stream:
+              x-stream-item:
+                $ref: '#/components/schemas/Event'
+  /lines:
+    get:
+      operationId: streamLines
+      responses:
+        '201':
+          description: Lines of JSON
+          content:
+            application/x-ndjson:
+              itemSchema:
+                type: object
+                properties:
+                  line:
+                    $ref: '#/components/schemas/Line'
+  /raw:
+    get:
+      operationId: streamRaw
+      responses:
+        '200':
+          description: Events without an item schema
+          content:
+            text/event-stream:
+              schema:
+                type: string
+components:
+  schemas:
+    Event:
+      type: object
+      properties:
+        message:
+          type: string
+        parent:
+          $ref: '#/components/schemas/Event'
+    Line:
+      type: string
+`
+
+func loadStreamSpec(t *testing.T) *openapi3.T {
+	t.Helper()
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(streamSpec))
+	require.NoError(t, err)
+	return swagger
+}
+
+func TestIsStreamMediaType(t *testing.T) {
+	assert.True(t, isStreamMediaType("text/event-stream"))
+	assert.True(t, isStreamMediaType("text/event-stream; charset=utf-8"))
+	assert.True(t, isStreamMediaType("application/x-ndjson"))
+	assert.False(t, isStreamMediaType("application/json"))
+	assert.False(t, isStreamMediaType("text/plain"))
+}
+
+func TestDecodeStreamItemSchemas(t *testing.T) {
+	swagger := loadStreamSpec(t)
+	require.NoError(t, decodeStreamItemSchemas(swagger))
+
+	events := streamItemSchema(swagger.Paths.Value("/events").Get.Responses.Status(200).Value.Content["text/event-stream"])
+	require.NotNil(t, events)
+	assert.Equal(t, "#/components/schemas/Event", events.Ref)
+	assert.Same(t, swagger.Components.Schemas["Event"].Value, events.Value)
+
+	lines := streamItemSchema(swagger.Paths.Value("/lines").Get.Responses.Status(201).Value.Content["application/x-ndjson"])
+	require.NotNil(t, lines)
+	require.NotNil(t, lines.Value)
+	assert.Same(t, swagger.Components.Schemas["Line"].Value, lines.Value.Properties["line"].Value)
+
+	assert.Nil(t, streamItemSchema(swagger.Paths.Value("/raw").Get.Responses.Status(200).Value.Content["text/event-stream"]))
+
+	// Decoding is only done once
+	require.NoError(t, decodeStreamItemSchemas(swagger))
+	assert.Same(t, events, streamItemSchema(swagger.Paths.Value("/events").Get.Responses.Status(200).Value.Content["text/event-stream"]))
+}
+
+func TestDecodeStreamItemSchemasUnresolved(t *testing.T) {
+	swagger := loadStreamSpec(t)
+	swagger.Paths.Value("/events").Get.Responses.Status(200).Value.Content["text/event-stream"].Extensions[extStreamItem] = map[string]interface{}{
+		"$ref": "#/components/schemas/Missing",
+	}
+
+	err := decodeStreamItemSchemas(swagger)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unresolved reference #/components/schemas/Missing")
+}
+
+func TestResponseStream(t *testing.T) {
+	swagger := loadStreamSpec(t)
+	require.NoError(t, decodeStreamItemSchemas(swagger))
+	ops, err := defaultGenerator.OperationDefinitions(swagger, false)
+	require.NoError(t, err)
+	require.Len(t, ops, 3)
+
+	events := ops[0].ResponseStream()
+	require.NotNil(t, events)
+	assert.Equal(t, 200, events.StatusCode)
+	assert.True(t, events.Content.IsEventStream())
+	assert.Equal(t, "Event", events.ItemType())
+
+	lines := ops[1].ResponseStream()
+	require.NotNil(t, lines)
+	assert.Equal(t, 201, lines.StatusCode)
+	assert.False(t, lines.Content.IsEventStream())
+	assert.Contains(t, lines.ItemType(), "Line *Line")
+
+	assert.Nil(t, ops[2].ResponseStream())
+}
+
+func TestGenerateStreams(t *testing.T) {
+	opts := Configuration{
+		PackageName: "api",
+		Generate: GenerateOptions{
+			Models:    true,
+			Client:    true,
+			ChiServer: true,
+			Strict:    true,
+		},
+	}
+
+	code, err := Generate(loadStreamSpec(t), opts)
+	require.NoError(t, err)
+	_, err = format.Source([]byte(code))
+	require.NoError(t, err)
+
+	// The schemas only referred to by the item schemas aren't pruned
+	assert.Contains(t, code, "type Event struct {")
+	assert.Contains(t, code, "type Line = string")
+
+	assert.Contains(t, code, "func (c *ClientWithResponses) StreamEventsWithStream(ctx context.Context, reqEditors ...RequestEditorFn) (*StreamEventsStream, error) {")
+	assert.Contains(t, code, "func (s *StreamEventsStream) Next() (Event, error) {")
+	assert.Contains(t, code, "decoder:      newStreamDecoder(rsp, true),")
+	assert.Contains(t, code, "decoder:      newStreamDecoder(rsp, false),")
+	assert.NotContains(t, code, "StreamRawWithStream")
+
+	assert.Contains(t, code, "Events   <-chan Event")
+	assert.Contains(t, code, "EventSeq func(yield func(Event) bool)")
+	assert.Contains(t, code, `_, err = fmt.Fprintf(w, "data: %s\n\n", data)`)
+	assert.Contains(t, code, `_, err = w.Write(append(data, '\n'))`)
+	assert.Contains(t, code, "type StreamRaw200TexteventStreamResponse struct {\n\tBody          io.Reader")
+}
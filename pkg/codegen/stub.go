@@ -0,0 +1,325 @@
+package codegen
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// StubOperation describes how the stub server generated for the strict server
+// responds to an operation.
+type StubOperation struct {
+	OperationId string
+	// Examples are the responses with each of the named examples of the
+	// operation's responses, which are selected with the Prefer header
+	Examples []StubExample
+	// Response is the response when no example is selected, or nil if none of
+	// the operation's responses can be stubbed
+	Response *StubResponse
+}
+
+// StubExample is a response of a StubOperation with a named example.
+type StubExample struct {
+	Name     string
+	Response StubResponse
+}
+
+// StubResponse is a response of a StubOperation, which is returned as the
+// Go expression Value, which refers to the body, if any, as `body`.
+type StubResponse struct {
+	// BodyType is the type the body is decoded into from BodyJSON, or "" if
+	// the response has no body
+	BodyType string
+	BodyJSON string
+	Value    string
+}
+
+// BodyLiteral returns BodyJSON as a Go string literal, which is raw where
+// possible, to keep it readable.
+func (r StubResponse) BodyLiteral() string {
+	if strings.Contains(r.BodyJSON, "`") {
+		return strconv.Quote(r.BodyJSON)
+	}
+	return "`" + r.BodyJSON + "`"
+}
+
+// StubOperations returns how the stub server responds to each of the
+// operations. Each responds with its first successful response, or else its
+// default or first response, whose body is the first example of its content,
+// from its `example`, its `examples` in order of their names, or the example of
+// its schema, or else a value synthesized from its schema. Only JSON and plain
+// text content can be stubbed, so responses with neither are skipped.
+func (g *Generator) StubOperations(ops []OperationDefinition) ([]StubOperation, error) {
+	stubs := make([]StubOperation, 0, len(ops))
+	for _, op := range ops {
+		stub := StubOperation{OperationId: op.OperationId}
+		named := make(map[string]bool)
+
+		for _, rd := range stubResponseOrder(op.Responses) {
+			if len(rd.Contents) == 0 {
+				if stub.Response == nil {
+					response := g.stubResponse(op, rd, nil, "")
+					stub.Response = &response
+				}
+				continue
+			}
+
+			for _, content := range rd.Contents {
+				mediaType := stubMediaType(op, rd, content)
+				if mediaType == nil || rd.IsExternalRef() || !(content.IsJSON() || content.NameTag == "Text") {
+					continue
+				}
+
+				for _, name := range SortedMapKeys(mediaType.Examples) {
+					example := mediaType.Examples[name]
+					if named[name] || example == nil || example.Value == nil {
+						continue
+					}
+					body, err := json.Marshal(example.Value.Value)
+					if err != nil {
+						return nil, fmt.Errorf("error encoding example %s of operation %s: %w", name, op.OperationId, err)
+					}
+					named[name] = true
+					stub.Examples = append(stub.Examples, StubExample{
+						Name:     name,
+						Response: g.stubResponse(op, rd, &content, string(body)),
+					})
+				}
+
+				if stub.Response == nil {
+					body, err := json.Marshal(firstStubExample(mediaType))
+					if err != nil {
+						return nil, fmt.Errorf("error encoding example of operation %s: %w", op.OperationId, err)
+					}
+					response := g.stubResponse(op, rd, &content, string(body))
+					stub.Response = &response
+				}
+			}
+		}
+		stubs = append(stubs, stub)
+	}
+	return stubs, nil
+}
+
+// stubResponseOrder orders the responses in which they're preferred by the
+// stub server: successful responses, then the default response, then the
+// rest.
+func stubResponseOrder(responses []ResponseDefinition) []ResponseDefinition {
+	var successful, fallback, rest []ResponseDefinition
+	for _, rd := range responses {
+		switch {
+		case strings.HasPrefix(rd.StatusCode, "2"):
+			successful = append(successful, rd)
+		case rd.StatusCode == "default":
+			fallback = append(fallback, rd)
+		default:
+			rest = append(rest, rd)
+		}
+	}
+	return append(append(successful, fallback...), rest...)
+}
+
+// stubMediaType returns the media type of the spec which the content was
+// generated for.
+func stubMediaType(op OperationDefinition, rd ResponseDefinition, content ResponseContentDefinition) *openapi3.MediaType {
+	if op.Spec == nil || op.Spec.Responses == nil {
+		return nil
+	}
+	response := op.Spec.Responses.Value(rd.StatusCode)
+	if response == nil || response.Value == nil {
+		return nil
+	}
+	return response.Value.Content.Get(content.ContentType)
+}
+
+// stubStatusCode returns the status code the stub server responds with for
+// the status code of a response of the operation, which may be a range, such
+// as 2XX, or default. The default response is taken to be successful, unless
+// the operation has a successful response of its own.
+func stubStatusCode(op OperationDefinition, statusCode string) int {
+	if code, err := strconv.Atoi(statusCode); err == nil {
+		return code
+	}
+	if len(statusCode) == 3 && strings.HasSuffix(strings.ToUpper(statusCode), "XX") {
+		if class, err := strconv.Atoi(statusCode[:1]); err == nil {
+			return class * 100
+		}
+	}
+	for _, rd := range op.Responses {
+		if strings.HasPrefix(rd.StatusCode, "2") {
+			return 500
+		}
+	}
+	return 200
+}
+
+// stubResponse returns the response of the strict server to the operation for
+// the response definition, with the content, if any, whose body is encoded
+// as bodyJSON.
+func (g *Generator) stubResponse(op OperationDefinition, rd ResponseDefinition, content *ResponseContentDefinition, bodyJSON string) StubResponse {
+	opid := op.OperationId
+	fixedStatusCode := rd.HasFixedStatusCode()
+
+	if content == nil {
+		var fields []string
+		if !fixedStatusCode {
+			fields = append(fields, fmt.Sprintf("StatusCode: %d", stubStatusCode(op, rd.StatusCode)))
+		}
+		return StubResponse{Value: fmt.Sprintf("%s%sResponse{%s}", opid, rd.StatusCode, strings.Join(fields, ", "))}
+	}
+
+	typeName := opid + rd.StatusCode + content.NameTagOrContentType() + "Response"
+	hasHeaders := len(rd.Headers) > 0
+
+	var fields []string
+	if !content.HasFixedContentType() {
+		fields = append(fields, fmt.Sprintf("ContentType: %q", content.ContentType))
+	}
+
+	if fixedStatusCode && rd.IsRef() {
+		refTypeName := UppercaseFirstCharacterWithPkgName(rd.Ref) + content.NameTagOrContentType() + "Response"
+		if !hasHeaders {
+			return StubResponse{
+				BodyType: refTypeName,
+				BodyJSON: bodyJSON,
+				Value:    fmt.Sprintf("%s{%s: body}", typeName, refTypeName),
+			}
+		}
+		return StubResponse{
+			BodyType: content.Schema.TypeDecl(),
+			BodyJSON: bodyJSON,
+			Value:    fmt.Sprintf("%s{%s: %s{%s}}", typeName, refTypeName, refTypeName, strings.Join(append([]string{"Body: body"}, fields...), ", ")),
+		}
+	}
+
+	if !hasHeaders && fixedStatusCode {
+		return StubResponse{BodyType: typeName, BodyJSON: bodyJSON, Value: "body"}
+	}
+
+	fields = append([]string{"Body: body"}, fields...)
+	if !fixedStatusCode {
+		fields = append(fields, fmt.Sprintf("StatusCode: %d", stubStatusCode(op, rd.StatusCode)))
+	}
+	return StubResponse{
+		BodyType: content.Schema.TypeDecl(),
+		BodyJSON: bodyJSON,
+		Value:    fmt.Sprintf("%s{%s}", typeName, strings.Join(fields, ", ")),
+	}
+}
+
+// firstStubExample returns the first example of the media type, or a value
+// synthesized from its schema if it has none.
+func firstStubExample(mediaType *openapi3.MediaType) interface{} {
+	if mediaType.Example != nil {
+		return mediaType.Example
+	}
+	for _, name := range SortedMapKeys(mediaType.Examples) {
+		if example := mediaType.Examples[name]; example != nil && example.Value != nil {
+			return example.Value.Value
+		}
+	}
+	if mediaType.Schema == nil {
+		return nil
+	}
+	return synthesizeExample(mediaType.Schema.Value, make(map[*openapi3.Schema]bool))
+}
+
+// synthesizeExample returns a value which is valid against the schema, as far
+// as is simple, from its example or default, or else from its type. Recursive
+// schemas are cut short with null.
+func synthesizeExample(schema *openapi3.Schema, visiting map[*openapi3.Schema]bool) interface{} {
+	if schema == nil || visiting[schema] {
+		return nil
+	}
+	visiting[schema] = true
+	defer delete(visiting, schema)
+
+	switch {
+	case schema.Example != nil:
+		return schema.Example
+	case schema.Default != nil:
+		return schema.Default
+	case len(schema.Enum) > 0:
+		return schema.Enum[0]
+	case len(schema.OneOf) > 0:
+		return synthesizeExample(schema.OneOf[0].Value, visiting)
+	case len(schema.AnyOf) > 0:
+		return synthesizeExample(schema.AnyOf[0].Value, visiting)
+	case len(schema.AllOf) > 0:
+		merged := make(map[string]interface{})
+		for _, s := range schema.AllOf {
+			if object, ok := synthesizeExample(s.Value, visiting).(map[string]interface{}); ok {
+				for name, value := range object {
+					merged[name] = value
+				}
+			}
+		}
+		return merged
+	}
+
+	switch {
+	case schema.Type.Is("object") || len(schema.Properties) > 0:
+		object := make(map[string]interface{}, len(schema.Properties))
+		for name, property := range schema.Properties {
+			if property == nil {
+				continue
+			}
+			if value := synthesizeExample(property.Value, visiting); value != nil {
+				object[name] = value
+			}
+		}
+		return object
+	case schema.Type.Is("array"):
+		if schema.Items == nil {
+			return []interface{}{}
+		}
+		item := synthesizeExample(schema.Items.Value, visiting)
+		if item == nil {
+			return []interface{}{}
+		}
+		return []interface{}{item}
+	case schema.Type.Is("string"):
+		return synthesizeString(schema)
+	case schema.Type.Is("integer"), schema.Type.Is("number"):
+		if schema.Min != nil {
+			return *schema.Min
+		}
+		return 0
+	case schema.Type.Is("boolean"):
+		return false
+	}
+	return nil
+}
+
+// synthesizeString returns a string in the format of the schema.
+func synthesizeString(schema *openapi3.Schema) string {
+	switch schema.Format {
+	case "date-time":
+		return "1970-01-01T00:00:00Z"
+	case "date":
+		return "1970-01-01"
+	case "uuid":
+		return "00000000-0000-0000-0000-000000000000"
+	case "email":
+		return "user@example.com"
+	case "uri", "url":
+		return "https://example.com"
+	case "byte":
+		return ""
+	}
+	return strings.Repeat("x", int(schema.MinLength))
+}
+
+// GenerateStubServer generates the stub server for the operations, which
+// implements the strict server.
+func (g *Generator) GenerateStubServer(t *template.Template, ops []OperationDefinition) (string, error) {
+	stubs, err := g.StubOperations(ops)
+	if err != nil {
+		return "", err
+	}
+	return GenerateTemplates([]string{"strict/strict-stub.tmpl"}, t, stubs)
+}
@@ -0,0 +1,138 @@
+package codegen
+
+import (
+	"go/format"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const stubSpec = `
+openapi: "3.0.0"
+info:
+  version: 1.0.0
+  title: Stubs
+paths:
+  /things:
+    get:
+      operationId: listThings
+      responses:
+        '200':
+          description: Things
+          headers:
+            X-Total:
+              schema:
+                type: integer
+          content:
+            application/json:
+              schema:
+                type: array
+                items:
+                  $ref: '#/components/schemas/Thing'
+              examples:
+                one:
+                  value: [{"name": "a"}]
+        default:
+          description: Error
+          content:
+            application/json:
+              schema:
+                type: object
+              example: {"message": "failed"}
+  /files:
+    get:
+      operationId: getFile
+      responses:
+        '200':
+          description: A file
+          content:
+            application/octet-stream: {}
+components:
+  schemas:
+    Thing:
+      type: object
+      properties:
+        name:
+          type: string
+        tags:
+          type: array
+          items:
+            type: string
+            format: uuid
+        size:
+          oneOf:
+            - type: integer
+              minimum: 5
+            - type: string
+        child:
+          $ref: '#/components/schemas/Thing'
+`
+
+func TestSynthesizeExample(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(stubSpec))
+	require.NoError(t, err)
+
+	example := synthesizeExample(swagger.Components.Schemas["Thing"].Value, make(map[*openapi3.Schema]bool))
+	assert.Equal(t, map[string]interface{}{
+		"name": "",
+		"tags": []interface{}{"00000000-0000-0000-0000-000000000000"},
+		"size": float64(5),
+	}, example, "the recursive child is left out")
+}
+
+func TestStubOperations(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(stubSpec))
+	require.NoError(t, err)
+	ops, err := defaultGenerator.OperationDefinitions(swagger, false)
+	require.NoError(t, err)
+
+	stubs, err := defaultGenerator.StubOperations(ops)
+	require.NoError(t, err)
+	require.Len(t, stubs, 2)
+
+	assert.Equal(t, "GetFile", stubs[0].OperationId)
+	assert.Nil(t, stubs[0].Response)
+
+	things := stubs[1]
+	require.NotNil(t, things.Response)
+	assert.Equal(t, StubResponse{
+		BodyType: "[]Thing",
+		BodyJSON: `[{"name":"a"}]`,
+		Value:    "ListThings200JSONResponse{Body: body}",
+	}, *things.Response)
+	assert.Equal(t, []StubExample{{
+		Name:     "one",
+		Response: *things.Response,
+	}}, things.Examples)
+}
+
+func TestGenerateStubServer(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(stubSpec))
+	require.NoError(t, err)
+
+	opts := Configuration{
+		PackageName: "api",
+		Generate: GenerateOptions{
+			Models:     true,
+			ChiServer:  true,
+			Strict:     true,
+			StubServer: true,
+		},
+	}
+	code, err := Generate(swagger, opts)
+	require.NoError(t, err)
+	_, err = format.Source([]byte(code))
+	require.NoError(t, err)
+
+	assert.Contains(t, code, "var _ StrictServerInterface = StubServer{}")
+	assert.Contains(t, code, "func StubPreferMiddleware(next http.Handler) http.Handler {")
+	assert.Contains(t, code, "if err := json.Unmarshal([]byte(`[{\"name\":\"a\"}]`), &body); err != nil {")
+	assert.Contains(t, code, `return nil, errors.New("no response of GetFile can be stubbed")`)
+}
+
+func TestStubServerRequiresStrict(t *testing.T) {
+	problems := GenerateOptions{ChiServer: true, StubServer: true}.Validate()
+	assert.Equal(t, map[string]string{"stub-server": "requires `strict-server`"}, problems)
+}
@@ -0,0 +1,209 @@
+package codegen
+
+import (
+	"fmt"
+	"maps"
+	"path"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// DefaultModelsPackage is the name of the shared models package generated by
+// GenerateTagPackages, unless configured otherwise.
+const DefaultModelsPackage = "models"
+
+// GenerateTagPackages generates one Go package per OpenAPI tag, each of which
+// contains the client and server code, as well as the operation specific
+// types, for the operations with that tag. The types for the components of
+// the spec are generated once, in a shared models package, which each of the
+// tag packages imports.
+//
+// Operations are placed in the package of their first tag, and operations
+// without any tags are placed in the package named by opts.PackageName, as are
+// any webhooks.
+//
+// The result maps the directory of each package, relative to
+// opts.OutputOptions.TagPackages.BaseImportPath, to the files of that package,
+// as laid out by GenerateFiles.
+func GenerateTagPackages(spec *openapi3.T, opts Configuration) (map[string]map[string]string, error) {
+	tagOpts := opts.OutputOptions.TagPackages
+	if tagOpts == nil {
+		return nil, fmt.Errorf("`output-options.tag-packages` must be configured to generate a package per tag")
+	}
+	if !opts.Generate.Models {
+		return nil, fmt.Errorf("`generate.models` must be enabled to generate a package per tag, as the tag packages refer to the types of the shared models package")
+	}
+
+	modelsPackage := tagOpts.ModelsPackage
+	if modelsPackage == "" {
+		modelsPackage = DefaultModelsPackage
+	}
+	models := goImport{
+		Name: modelsPackage,
+		Path: path.Join(tagOpts.BaseImportPath, modelsPackage),
+	}
+
+	// Apply any filtering up front, so that the models package is pruned
+	// in the same way as the rest of the generated code.
+	spec = cloneSpec(spec, nil)
+	if err := normalizeOpenAPI31(spec); err != nil {
+		return nil, fmt.Errorf("error normalizing OpenAPI 3.1 spec: %w", err)
+	}
+	if err := decodeStreamItemSchemas(spec); err != nil {
+		return nil, fmt.Errorf("error decoding streamed responses: %w", err)
+	}
+	filterOperationsByTag(spec, opts)
+	filterOperationsByOperationID(spec, opts)
+
+	packageOps, err := operationsByTagPackage(spec, opts.PackageName)
+	if err != nil {
+		return nil, err
+	}
+	// Webhooks don't have tags, so are generated in the default package.
+	hasWebhooks := opts.Generate.WebhookClient || opts.Generate.WebhookServer
+	if hasWebhooks && len(specWebhooks(spec)) > 0 && packageOps[opts.PackageName] == nil {
+		packageOps[opts.PackageName] = map[*openapi3.Operation]bool{}
+	}
+	if _, ok := packageOps[modelsPackage]; ok {
+		return nil, fmt.Errorf("the package for tag operations %q clashes with the models package; please configure a different `models-package`", modelsPackage)
+	}
+
+	// The operations are filtered per package below, so they shouldn't be
+	// filtered again.
+	opts.OutputOptions.IncludeTags = nil
+	opts.OutputOptions.ExcludeTags = nil
+	opts.OutputOptions.IncludeOperationIDs = nil
+	opts.OutputOptions.ExcludeOperationIDs = nil
+
+	out := make(map[string]map[string]string, len(packageOps)+1)
+
+	modelsSpec := cloneSpec(spec, nil)
+	if !opts.OutputOptions.SkipPrune {
+		pruneUnusedComponents(modelsSpec)
+	}
+	modelsSpec.Paths = openapi3.NewPaths()
+
+	modelsOpts := opts
+	modelsOpts.PackageName = modelsPackage
+	modelsOpts.Generate = GenerateOptions{Models: true}
+	modelsOpts.OutputOptions.SkipPrune = true
+
+	files, err := generatePackageFiles(modelsSpec, modelsOpts, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error generating package %s: %w", modelsPackage, err)
+	}
+	out[modelsPackage] = files
+
+	for packageName, ops := range packageOps {
+		packageOpts := opts
+		packageOpts.PackageName = packageName
+
+		packageSpec := cloneSpec(spec, ops)
+		if packageName != opts.PackageName {
+			delete(packageSpec.Extensions, keywordWebhooks)
+		}
+
+		files, err := generatePackageFiles(packageSpec, packageOpts, &models)
+		if err != nil {
+			return nil, fmt.Errorf("error generating package %s: %w", packageName, err)
+		}
+		out[packageName] = files
+	}
+
+	return out, nil
+}
+
+func generatePackageFiles(spec *openapi3.T, opts Configuration, models *goImport) (map[string]string, error) {
+	g, err := newGenerator(spec, opts, models)
+	if err != nil {
+		return nil, err
+	}
+	return g.GenerateFiles()
+}
+
+// operationsByTagPackage groups the operations of the spec by the name of the
+// package they're generated in.
+func operationsByTagPackage(spec *openapi3.T, defaultPackage string) (map[string]map[*openapi3.Operation]bool, error) {
+	result := map[string]map[*openapi3.Operation]bool{}
+	if spec.Paths == nil {
+		return result, nil
+	}
+
+	for _, pathItem := range spec.Paths.Map() {
+		for _, op := range pathItem.Operations() {
+			packageName := defaultPackage
+			if len(op.Tags) > 0 {
+				var err error
+				packageName, err = TagToPackageName(op.Tags[0])
+				if err != nil {
+					return nil, err
+				}
+			}
+			if result[packageName] == nil {
+				result[packageName] = map[*openapi3.Operation]bool{}
+			}
+			result[packageName][op] = true
+		}
+	}
+	return result, nil
+}
+
+// TagToPackageName converts an OpenAPI tag to the name of the Go package its
+// operations are generated in, by lowercasing it and dropping any characters
+// which can't be used in a package name.
+// "Pet Store" -> petstore
+// "user-accounts" -> useraccounts
+func TagToPackageName(tag string) (string, error) {
+	var sb strings.Builder
+	for _, r := range strings.ToLower(tag) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			sb.WriteRune(r)
+		}
+	}
+	name := sb.String()
+
+	if name == "" || (name[0] >= '0' && name[0] <= '9') || IsGoKeyword(name) {
+		return "", fmt.Errorf("tag %q can't be converted to a valid Go package name", tag)
+	}
+	return name, nil
+}
+
+// cloneSpec makes a copy of the spec which can be filtered and pruned without
+// affecting the original. When ops is non-nil, only those operations are kept.
+func cloneSpec(spec *openapi3.T, ops map[*openapi3.Operation]bool) *openapi3.T {
+	clone := *spec
+	clone.Extensions = maps.Clone(spec.Extensions)
+
+	if spec.Paths != nil {
+		clone.Paths = openapi3.NewPaths()
+		for k, v := range spec.Paths.Map() {
+			pathItem := *v
+			for method, op := range pathItem.Operations() {
+				if ops != nil && !ops[op] {
+					pathItem.SetOperation(method, nil)
+				}
+			}
+			if ops != nil && len(pathItem.Operations()) == 0 {
+				continue
+			}
+			clone.Paths.Set(k, &pathItem)
+		}
+	}
+
+	if spec.Components != nil {
+		components := *spec.Components
+		components.Schemas = maps.Clone(spec.Components.Schemas)
+		components.Parameters = maps.Clone(spec.Components.Parameters)
+		components.Headers = maps.Clone(spec.Components.Headers)
+		components.RequestBodies = maps.Clone(spec.Components.RequestBodies)
+		components.Responses = maps.Clone(spec.Components.Responses)
+		components.SecuritySchemes = maps.Clone(spec.Components.SecuritySchemes)
+		components.Examples = maps.Clone(spec.Components.Examples)
+		components.Links = maps.Clone(spec.Components.Links)
+		components.Callbacks = maps.Clone(spec.Components.Callbacks)
+		clone.Components = &components
+	}
+
+	return &clone
+}
@@ -0,0 +1,146 @@
+package codegen
+
+import (
+	"go/format"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oapi-codegen/oapi-codegen/v2/pkg/util"
+)
+
+func TestGenerateTagPackages(t *testing.T) {
+	opts := Configuration{
+		PackageName: "api",
+		Generate: GenerateOptions{
+			ChiServer:    true,
+			Strict:       true,
+			Client:       true,
+			Models:       true,
+			EmbeddedSpec: true,
+		},
+		OutputOptions: OutputOptions{
+			TagPackages: &TagPackagesOptions{
+				BaseImportPath: "example.com/api",
+			},
+		},
+	}
+
+	swagger, err := util.LoadSwagger("test_specs/tag-packages.yaml")
+	require.NoError(t, err)
+
+	packages, err := GenerateTagPackages(swagger, opts)
+	require.NoError(t, err)
+
+	require.Len(t, packages, 4)
+	for dir, files := range packages {
+		for name, code := range files {
+			// Check that we have valid (formattable) code:
+			_, err = format.Source([]byte(code))
+			assert.NoError(t, err)
+
+			assert.Contains(t, code, "package "+dir, "in %s/%s", dir, name)
+		}
+	}
+
+	// Component types are only generated in the models package
+	models := packages[DefaultModelsPackage]
+	require.Len(t, models, 1)
+	assert.Contains(t, models[TypesFileName], "type Pet struct {")
+	assert.Contains(t, models[TypesFileName], "type NotFound = Error")
+	assert.NotContains(t, models[TypesFileName], "type Unused")
+	assert.NotContains(t, models[TypesFileName], "ListPetsParams")
+
+	// Operations are generated in the package of their first tag
+	pets := packages["pets"]
+	assert.Contains(t, pets[TypesFileName], `models "example.com/api/models"`)
+	assert.Contains(t, pets[TypesFileName], "Limit *models.Limit")
+	assert.Contains(t, pets[TypesFileName], "type AddPetJSONRequestBody = models.Pet")
+	assert.NotContains(t, pets[TypesFileName], "type Pet struct {")
+	assert.Contains(t, pets[ServerFileName], "ListPets(ctx context.Context, request ListPetsRequestObject) (ListPetsResponseObject, error)")
+	assert.NotContains(t, pets[ServerFileName], "GetOrder")
+	// Strict responses for components are generated alongside the server
+	assert.Contains(t, pets[ServerFileName], "type NotFoundJSONResponse models.Error")
+	assert.Contains(t, pets[ServerFileName], "type ListPets404JSONResponse struct{ NotFoundJSONResponse }")
+
+	storeOrders := packages["storeorders"]
+	assert.Contains(t, storeOrders[ClientFileName], "func (c *Client) GetOrder(")
+	assert.Contains(t, storeOrders[ClientFileName], "JSON404 *models.NotFound")
+	assert.NotContains(t, storeOrders[ClientFileName], "ListPets")
+
+	// Untagged operations are generated in the configured package
+	untagged := packages["api"]
+	assert.Contains(t, untagged[ServerFileName], "Health(w http.ResponseWriter, r *http.Request)")
+	assert.NotContains(t, untagged[ServerFileName], "models.")
+}
+
+func TestGenerateTagPackagesModelsPackageClash(t *testing.T) {
+	opts := Configuration{
+		PackageName: "api",
+		Generate: GenerateOptions{
+			Client: true,
+			Models: true,
+		},
+		OutputOptions: OutputOptions{
+			TagPackages: &TagPackagesOptions{
+				BaseImportPath: "example.com/api",
+				ModelsPackage:  "pets",
+			},
+		},
+	}
+
+	swagger, err := util.LoadSwagger("test_specs/tag-packages.yaml")
+	require.NoError(t, err)
+
+	_, err = GenerateTagPackages(swagger, opts)
+	assert.ErrorContains(t, err, "clashes with the models package")
+}
+
+func TestGenerateTagPackagesWithoutModels(t *testing.T) {
+	opts := Configuration{
+		PackageName: "api",
+		Generate: GenerateOptions{
+			Client: true,
+		},
+		OutputOptions: OutputOptions{
+			TagPackages: &TagPackagesOptions{
+				BaseImportPath: "example.com/api",
+			},
+		},
+	}
+	assert.ErrorContains(t, opts.Validate(), "`output-options` configuration for tag-packages was incorrect: requires `generate.models`")
+
+	swagger, err := util.LoadSwagger("test_specs/tag-packages.yaml")
+	require.NoError(t, err)
+
+	_, err = GenerateTagPackages(swagger, opts)
+	assert.ErrorContains(t, err, "`generate.models` must be enabled to generate a package per tag")
+}
+
+func TestTagToPackageName(t *testing.T) {
+	tests := []struct {
+		tag      string
+		expected string
+		wantErr  bool
+	}{
+		{tag: "pets", expected: "pets"},
+		{tag: "Pet Store", expected: "petstore"},
+		{tag: "user-accounts", expected: "useraccounts"},
+		{tag: "v2_orders", expected: "v2orders"},
+		{tag: "2fa", wantErr: true},
+		{tag: "type", wantErr: true},
+		{tag: "---", wantErr: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.tag, func(t *testing.T) {
+			name, err := TagToPackageName(tc.tag)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, name)
+		})
+	}
+}
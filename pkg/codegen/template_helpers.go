@@ -38,10 +38,6 @@ var (
 	contentTypesHalJSON = []string{"application/hal+json"}
 	contentTypesYAML    = []string{"application/yaml", "application/x-yaml", "text/yaml", "text/x-yaml"}
 	contentTypesXML     = []string{"application/xml", "text/xml", "application/problems+xml"}
-
-	responseTypeSuffix = "Response"
-
-	titleCaser = cases.Title(language.English)
 )
 
 // genParamArgs takes an array of Parameter definition, and generates a valid
@@ -90,11 +86,11 @@ func genParamNames(params []ParameterDefinition) string {
 }
 
 // genResponsePayload generates the payload returned at the end of each client request function
-func genResponsePayload(operationID string) string {
+func (g *Generator) genResponsePayload(operationID string) string {
 	var buffer = bytes.NewBufferString("")
 
 	// Here is where we build up a response:
-	fmt.Fprintf(buffer, "&%s{\n", genResponseTypeName(operationID))
+	fmt.Fprintf(buffer, "&%s{\n", g.genResponseTypeName(operationID))
 	fmt.Fprintf(buffer, "Body: bodyBytes,\n")
 	fmt.Fprintf(buffer, "HTTPResponse: rsp,\n")
 	fmt.Fprintf(buffer, "}")
@@ -257,8 +253,8 @@ func buildUnmarshalCaseStrict(typeDefinition ResponseTypeDefinition, caseAction
 }
 
 // genResponseTypeName creates the name of generated response types (given the operationID):
-func genResponseTypeName(operationID string) string {
-	return fmt.Sprintf("%s%s", UppercaseFirstCharacter(operationID), responseTypeSuffix)
+func (g *Generator) genResponseTypeName(operationID string) string {
+	return fmt.Sprintf("%s%s", UppercaseFirstCharacter(operationID), g.responseTypeSuffix)
 }
 
 func getResponseTypeDefinitions(op *OperationDefinition) []ResponseTypeDefinition {
@@ -295,8 +291,15 @@ func stripNewLines(s string) string {
 	return r.Replace(s)
 }
 
+// title converts a string to title case. A cases.Caser isn't safe to use
+// concurrently, so a new one is created each time.
+func title(s string) string {
+	return cases.Title(language.English).String(s)
+}
+
 // TemplateFunctions is passed to the template engine, and we can call each
-// function here by keyName from the template code.
+// function here by keyName from the template code. The functions which depend
+// on the Configuration use the default one; a Generator binds them to its own.
 var TemplateFunctions = template.FuncMap{
 	"genParamArgs":               genParamArgs,
 	"genParamTypes":              genParamTypes,
@@ -309,17 +312,19 @@ var TemplateFunctions = template.FuncMap{
 	"swaggerUriToGinUri":         SwaggerUriToGinUri,
 	"swaggerUriToGorillaUri":     SwaggerUriToGorillaUri,
 	"swaggerUriToStdHttpUri":     SwaggerUriToStdHttpUri,
+	"swaggerUriToHttprouterUri":  SwaggerUriToHttprouterUri,
+	"swaggerUriToFasthttpUri":    SwaggerUriToFasthttpUri,
 	"lcFirst":                    LowercaseFirstCharacter,
 	"ucFirst":                    UppercaseFirstCharacter,
 	"ucFirstWithPkgName":         UppercaseFirstCharacterWithPkgName,
 	"camelCase":                  ToCamelCase,
-	"genResponsePayload":         genResponsePayload,
-	"genResponseTypeName":        genResponseTypeName,
+	"genResponsePayload":         defaultGenerator.genResponsePayload,
+	"genResponseTypeName":        defaultGenerator.genResponseTypeName,
 	"genResponseUnmarshal":       genResponseUnmarshal,
 	"getResponseTypeDefinitions": getResponseTypeDefinitions,
 	"toStringArray":              toStringArray,
 	"lower":                      strings.ToLower,
-	"title":                      titleCaser.String,
+	"title":                      title,
 	"stripNewLines":              stripNewLines,
 	"sanitizeGoIdentity":         SanitizeGoIdentity,
 	"toGoComment":                StringWithTypeNameToGoComment,
@@ -0,0 +1,96 @@
+package codegen
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// TypeMapping maps OpenAPI types and formats to the Go types which represent
+// them, taking precedence over the built-in mapping, such as `string` with the
+// `date-time` format to time.Time. It's keyed by the OpenAPI type, and then by
+// the format, where an empty format is the type without a format.
+type TypeMapping map[string]map[string]TypeMappingTarget
+
+// TypeMappingTarget is the Go type which an OpenAPI type and format are mapped
+// to.
+type TypeMappingTarget struct {
+	// GoType is the Go type, qualified with the name of its package if it
+	// isn't a builtin type, such as decimal.Decimal
+	GoType string `yaml:"go-type"`
+	// Import is the package which provides GoType, if any
+	Import *AdditionalImport `yaml:"import,omitempty"`
+}
+
+// typeMappingTypes are the OpenAPI types which can be mapped, as they're
+// represented by a single Go type.
+var typeMappingTypes = []string{"boolean", "integer", "number", "string"}
+
+// Validate checks the TypeMapping, returning any problems keyed by type and
+// format.
+func (tm TypeMapping) Validate() map[string]string {
+	problems := map[string]string{}
+	for _, t := range SortedMapKeys(tm) {
+		if !sliceContains(typeMappingTypes, t) {
+			problems[t] = fmt.Sprintf("only the types %q can be mapped", typeMappingTypes)
+			continue
+		}
+		for _, format := range SortedMapKeys(tm[t]) {
+			target := tm[t][format]
+			key := t
+			if format != "" {
+				key += "/" + format
+			}
+			if target.GoType == "" {
+				problems[key] = "`go-type` must be specified"
+			} else if target.Import != nil && target.Import.Package == "" {
+				problems[key] = "the `package` of the `import` must be specified"
+			}
+		}
+	}
+	if len(problems) == 0 {
+		return nil
+	}
+	return problems
+}
+
+// lookup returns the Go type which the OpenAPI type and format are mapped to,
+// if any.
+func (tm TypeMapping) lookup(t *openapi3.Types, format string) (TypeMappingTarget, bool) {
+	types := t.Slice()
+	if len(types) != 1 {
+		return TypeMappingTarget{}, false
+	}
+	target, ok := tm[types[0]][format]
+	return target, ok
+}
+
+// goImports returns the imports of the mapped Go types, which are added to
+// every generated file, and removed again by goimports where they're unused.
+// See missingImports.
+func (tm TypeMapping) goImports() map[string]goImport {
+	res := map[string]goImport{}
+	for _, formats := range tm {
+		for _, target := range formats {
+			if target.Import == nil {
+				continue
+			}
+			gi := goImport{Name: target.Import.Alias, Path: target.Import.Package}
+			res[gi.String()] = gi
+		}
+	}
+	return res
+}
+
+// missingImports returns the imports which aren't already among the imports
+// generated by the imports template, sorted.
+func missingImports(importsOut string, imprts map[string]goImport) []string {
+	var missing []string
+	for _, k := range SortedMapKeys(imprts) {
+		if !strings.Contains(importsOut, "\t"+k+"\n") {
+			missing = append(missing, k)
+		}
+	}
+	return missing
+}
@@ -0,0 +1,92 @@
+package codegen
+
+import (
+	"go/format"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oapi-codegen/oapi-codegen/v2/pkg/util"
+)
+
+func TestTypeMapping(t *testing.T) {
+	opts := Configuration{
+		PackageName: "api",
+		Generate: GenerateOptions{
+			Models: true,
+			Client: true,
+		},
+		TypeMapping: TypeMapping{
+			"number": {
+				"decimal": {GoType: "decimal.Decimal", Import: &AdditionalImport{Package: "github.com/shopspring/decimal"}},
+			},
+			"string": {
+				"ipv4":     {GoType: "netip.Addr", Import: &AdditionalImport{Package: "net/netip"}},
+				"duration": {GoType: "time.Duration", Import: &AdditionalImport{Package: "time"}},
+				"uuid":     {GoType: "string"},
+			},
+		},
+	}
+
+	swagger, err := util.LoadSwagger("test_specs/type-mapping.yaml")
+	require.NoError(t, err)
+
+	code, err := Generate(swagger, opts)
+	require.NoError(t, err)
+
+	_, err = format.Source([]byte(code))
+	require.NoError(t, err)
+
+	assert.Contains(t, code, "Amount  decimal.Decimal `json:\"amount\"`")
+	assert.Contains(t, code, "Address *netip.Addr")
+	assert.Contains(t, code, "Ttl     *time.Duration")
+	assert.Contains(t, code, "Ttl *time.Duration `form:\"ttl,omitempty\" json:\"ttl,omitempty\"`")
+	// Formats which aren't mapped use the built-in mapping, and built-in
+	// mappings can be overridden
+	assert.Contains(t, code, "At      *time.Time")
+	assert.Contains(t, code, "Id      *string")
+
+	assert.Contains(t, code, `"github.com/shopspring/decimal"`)
+	assert.Contains(t, code, `"net/netip"`)
+	// Packages which are already imported aren't imported again
+	assert.Equal(t, 1, strings.Count(code, `"time"`))
+	assert.NotContains(t, code, "openapi_types")
+}
+
+func TestTypeMappingUnmappedNumberFormat(t *testing.T) {
+	swagger, err := util.LoadSwagger("test_specs/type-mapping.yaml")
+	require.NoError(t, err)
+
+	_, err = Generate(swagger, Configuration{
+		PackageName: "api",
+		Generate: GenerateOptions{
+			Models: true,
+		},
+	})
+	assert.ErrorContains(t, err, "invalid number format: decimal")
+}
+
+func TestTypeMappingValidate(t *testing.T) {
+	assert.Nil(t, TypeMapping{
+		"string": {"": {GoType: "MyString"}},
+	}.Validate())
+
+	problems := TypeMapping{
+		"object":  {"": {GoType: "map[string]any"}},
+		"string":  {"ipv4": {}},
+		"integer": {"": {GoType: "big.Int", Import: &AdditionalImport{Alias: "big"}}},
+	}.Validate()
+	assert.Equal(t, map[string]string{
+		"object":      `only the types ["boolean" "integer" "number" "string"] can be mapped`,
+		"string/ipv4": "`go-type` must be specified",
+		"integer":     "the `package` of the `import` must be specified",
+	}, problems)
+
+	err := Configuration{
+		PackageName: "api",
+		TypeMapping: TypeMapping{"string": {"ipv4": {}}},
+	}.Validate()
+	assert.ErrorContains(t, err, "`type-mapping` configuration for string/ipv4 was incorrect: `go-type` must be specified")
+}
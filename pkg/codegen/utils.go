@@ -32,7 +32,6 @@ var (
 	pathParamRE    *regexp.Regexp
 	predeclaredSet map[string]struct{}
 	separatorSet   map[rune]struct{}
-	nameNormalizer NameNormalizer = ToCamelCase
 )
 
 type NameNormalizerFunction string
@@ -434,35 +433,56 @@ func RefPathToObjName(refPath string) string {
 // Remote components (document.json#/Foo) are supported if they present in --import-mapping
 // URL components (http://deepmap.com/schemas/document.json#/Foo) are supported if they present in --import-mapping
 // Remote and URL also support standard local paths even though the spec doesn't mention them.
+//
+// It uses the default Configuration; see Generator.RefPathToGoType.
 func RefPathToGoType(refPath string) (string, error) {
-	return refPathToGoType(refPath, true)
+	return defaultGenerator.RefPathToGoType(refPath)
+}
+
+// RefPathToGoType takes a $ref value and converts it to a Go typename, as
+// the package level RefPathToGoType, using the Generator's import mapping.
+func (g *Generator) RefPathToGoType(refPath string) (string, error) {
+	return g.refPathToGoType(refPath, true)
 }
 
 // refPathToGoType returns the Go typename for refPath given its
-func refPathToGoType(refPath string, local bool) (string, error) {
+func (g *Generator) refPathToGoType(refPath string, local bool) (string, error) {
 	if refPath[0] == '#' {
-		return refPathToGoTypeSelf(refPath, local)
+		if goPkg, ok := g.importMapping[importMappingLocalComponents]; ok && local {
+			return g.refPathToGoTypeRemote(refPath[1:], goPkg)
+		}
+		return g.refPathToGoTypeSelf(refPath, local)
 	}
 	pathParts := strings.Split(refPath, "#")
 	if len(pathParts) != 2 {
 		return "", fmt.Errorf("unsupported reference: %s", refPath)
 	}
 	remoteComponent, flatComponent := pathParts[0], pathParts[1]
-	goPkg, ok := globalState.importMapping[remoteComponent]
+	goPkg, ok := g.importMapping[remoteComponent]
 
 	if !ok {
 		return "", fmt.Errorf("unrecognized external reference '%s'; please provide the known import for this reference using option --import-mapping", remoteComponent)
 	}
 
 	if goPkg.Path == importMappingCurrentPackage {
-		return refPathToGoTypeSelf(fmt.Sprintf("#%s", pathParts[1]), local)
+		return g.refPathToGoType(fmt.Sprintf("#%s", pathParts[1]), local)
 	}
 
-	return refPathToGoTypeRemote(flatComponent, goPkg)
+	return g.refPathToGoTypeRemote(flatComponent, goPkg)
 
 }
 
-func refPathToGoTypeSelf(refPath string, local bool) (string, error) {
+// localRefPathToGoType is like RefPathToGoType, but references to the
+// components of the current spec always resolve to a type in the current
+// package, even when the types for the components are generated elsewhere.
+func (g *Generator) localRefPathToGoType(refPath string) (string, error) {
+	if refPath[0] == '#' {
+		return g.refPathToGoTypeSelf(refPath, true)
+	}
+	return g.RefPathToGoType(refPath)
+}
+
+func (g *Generator) refPathToGoTypeSelf(refPath string, local bool) (string, error) {
 	pathParts := strings.Split(refPath, "/")
 	depth := len(pathParts)
 	if local {
@@ -475,7 +495,7 @@ func refPathToGoTypeSelf(refPath string, local bool) (string, error) {
 
 	// Schemas may have been renamed locally, so look up the actual name in
 	// the spec.
-	name, err := findSchemaNameByRefPath(refPath, globalState.spec)
+	name, err := g.findSchemaNameByRefPath(refPath, g.spec)
 	if err != nil {
 		return "", fmt.Errorf("error finding ref: %s in spec: %v", refPath, err)
 	}
@@ -485,11 +505,11 @@ func refPathToGoTypeSelf(refPath string, local bool) (string, error) {
 	// lastPart now stores the final element of the type path. This is what
 	// we use as the base for a type name.
 	lastPart := pathParts[len(pathParts)-1]
-	return SchemaNameToTypeName(lastPart), nil
+	return g.SchemaNameToTypeName(lastPart), nil
 }
 
-func refPathToGoTypeRemote(flatComponent string, goPkg goImport) (string, error) {
-	goType, err := refPathToGoType("#"+flatComponent, false)
+func (g *Generator) refPathToGoTypeRemote(flatComponent string, goPkg goImport) (string, error) {
+	goType, err := g.refPathToGoType("#"+flatComponent, false)
 	if err != nil {
 		return "", err
 	}
@@ -626,6 +646,38 @@ func SwaggerUriToStdHttpUri(uri string) string {
 	return pathParamRE.ReplaceAllString(uri, "{$1}")
 }
 
+// SwaggerUriToHttprouterUri converts a swagger style path URI with parameters to
+// a httprouter compatible path URI. We need to replace all Swagger parameters
+// with ":param". Valid input parameters are:
+//
+//	{param}
+//	{param*}
+//	{.param}
+//	{.param*}
+//	{;param}
+//	{;param*}
+//	{?param}
+//	{?param*}
+func SwaggerUriToHttprouterUri(uri string) string {
+	return pathParamRE.ReplaceAllString(uri, ":$1")
+}
+
+// SwaggerUriToFasthttpUri converts a swagger style path URI with parameters to
+// a fasthttp/router compatible path URI. We need to replace all Swagger
+// parameters with "{param}". Valid input parameters are:
+//
+//	{param}
+//	{param*}
+//	{.param}
+//	{.param*}
+//	{;param}
+//	{;param*}
+//	{?param}
+//	{?param*}
+func SwaggerUriToFasthttpUri(uri string) string {
+	return pathParamRE.ReplaceAllString(uri, "{$1}")
+}
+
 // OrderedParamsFromUri returns the argument names, in order, in a given URI string, so for
 // /path/{param1}/{.param2*}/{?param3}, it would return param1, param2, param3
 func OrderedParamsFromUri(uri string) []string {
@@ -736,7 +788,15 @@ func SanitizeGoIdentity(str string) string {
 
 // SanitizeEnumNames fixes illegal chars in the enum names
 // and removes duplicates
+//
+// It uses the default Configuration; see Generator.SanitizeEnumNames.
 func SanitizeEnumNames(enumNames, enumValues []string) map[string]string {
+	return defaultGenerator.SanitizeEnumNames(enumNames, enumValues)
+}
+
+// SanitizeEnumNames fixes illegal chars in the enum names and removes
+// duplicates, using the Generator's name normalizer.
+func (g *Generator) SanitizeEnumNames(enumNames, enumValues []string) map[string]string {
 	dupCheck := make(map[string]int, len(enumValues))
 	deDup := make([][]string, 0, len(enumValues))
 
@@ -756,7 +816,7 @@ func SanitizeEnumNames(enumNames, enumValues []string) map[string]string {
 
 	for _, p := range deDup {
 		n, v := p[0], p[1]
-		sanitized := SanitizeGoIdentity(SchemaNameToTypeName(n))
+		sanitized := SanitizeGoIdentity(g.SchemaNameToTypeName(n))
 
 		if _, dup := dupCheck[sanitized]; !dup {
 			sanitizedDeDup[sanitized] = v
@@ -823,8 +883,16 @@ func typeNamePrefix(name string) (prefix string) {
 
 // SchemaNameToTypeName converts a Schema name to a valid Go type name. It converts to camel case, and makes sure the name is
 // valid in Go
+//
+// It uses the default Configuration; see Generator.SchemaNameToTypeName.
 func SchemaNameToTypeName(name string) string {
-	return typeNamePrefix(name) + nameNormalizer(name)
+	return defaultGenerator.SchemaNameToTypeName(name)
+}
+
+// SchemaNameToTypeName converts a Schema name to a valid Go type name, using
+// the Generator's name normalizer.
+func (g *Generator) SchemaNameToTypeName(name string) string {
+	return typeNamePrefix(name) + g.nameNormalizer(name)
 }
 
 // According to the spec, additionalProperties may be true, false, or a
@@ -846,9 +914,17 @@ func SchemaHasAdditionalProperties(schema *openapi3.Schema) bool {
 
 // PathToTypeName converts a path, like Object/field1/nestedField into a go
 // type name.
+//
+// It uses the default Configuration; see Generator.PathToTypeName.
 func PathToTypeName(path []string) string {
+	return defaultGenerator.PathToTypeName(path)
+}
+
+// PathToTypeName converts a path, like Object/field1/nestedField into a go
+// type name, using the Generator's name normalizer.
+func (g *Generator) PathToTypeName(path []string) string {
 	for i, p := range path {
-		path[i] = nameNormalizer(p)
+		path[i] = g.nameNormalizer(p)
 	}
 	return strings.Join(path, "_")
 }
@@ -919,10 +995,10 @@ func EscapePathElements(path string) string {
 // and the definition of the schema. If the schema overrides the name via
 // x-go-name, the new name is returned, otherwise, the original name is
 // returned.
-func renameSchema(schemaName string, schemaRef *openapi3.SchemaRef) (string, error) {
+func (g *Generator) renameSchema(schemaName string, schemaRef *openapi3.SchemaRef) (string, error) {
 	// References will not change type names.
 	if schemaRef.Ref != "" {
-		return SchemaNameToTypeName(schemaName), nil
+		return g.SchemaNameToTypeName(schemaName), nil
 	}
 	schema := schemaRef.Value
 
@@ -933,14 +1009,14 @@ func renameSchema(schemaName string, schemaRef *openapi3.SchemaRef) (string, err
 		}
 		return typeName, nil
 	}
-	return SchemaNameToTypeName(schemaName), nil
+	return g.SchemaNameToTypeName(schemaName), nil
 }
 
 // renameParameter generates the name for a parameter, taking x-go-name into
 // account
-func renameParameter(parameterName string, parameterRef *openapi3.ParameterRef) (string, error) {
+func (g *Generator) renameParameter(parameterName string, parameterRef *openapi3.ParameterRef) (string, error) {
 	if parameterRef.Ref != "" {
-		return SchemaNameToTypeName(parameterName), nil
+		return g.SchemaNameToTypeName(parameterName), nil
 	}
 	parameter := parameterRef.Value
 
@@ -951,14 +1027,14 @@ func renameParameter(parameterName string, parameterRef *openapi3.ParameterRef)
 		}
 		return typeName, nil
 	}
-	return SchemaNameToTypeName(parameterName), nil
+	return g.SchemaNameToTypeName(parameterName), nil
 }
 
 // renameResponse generates the name for a parameter, taking x-go-name into
 // account
-func renameResponse(responseName string, responseRef *openapi3.ResponseRef) (string, error) {
+func (g *Generator) renameResponse(responseName string, responseRef *openapi3.ResponseRef) (string, error) {
 	if responseRef.Ref != "" {
-		return SchemaNameToTypeName(responseName), nil
+		return g.SchemaNameToTypeName(responseName), nil
 	}
 	response := responseRef.Value
 
@@ -969,14 +1045,14 @@ func renameResponse(responseName string, responseRef *openapi3.ResponseRef) (str
 		}
 		return typeName, nil
 	}
-	return SchemaNameToTypeName(responseName), nil
+	return g.SchemaNameToTypeName(responseName), nil
 }
 
 // renameRequestBody generates the name for a parameter, taking x-go-name into
 // account
-func renameRequestBody(requestBodyName string, requestBodyRef *openapi3.RequestBodyRef) (string, error) {
+func (g *Generator) renameRequestBody(requestBodyName string, requestBodyRef *openapi3.RequestBodyRef) (string, error) {
 	if requestBodyRef.Ref != "" {
-		return SchemaNameToTypeName(requestBodyName), nil
+		return g.SchemaNameToTypeName(requestBodyName), nil
 	}
 	requestBody := requestBodyRef.Value
 
@@ -987,14 +1063,14 @@ func renameRequestBody(requestBodyName string, requestBodyRef *openapi3.RequestB
 		}
 		return typeName, nil
 	}
-	return SchemaNameToTypeName(requestBodyName), nil
+	return g.SchemaNameToTypeName(requestBodyName), nil
 }
 
 // findSchemaByRefPath turns a $ref path into a schema. This will return ""
 // if the schema wasn't found, and it'll only work successfully for schemas
 // defined within the spec that we parsed.
-func findSchemaNameByRefPath(refPath string, spec *openapi3.T) (string, error) {
-	if spec.Components == nil {
+func (g *Generator) findSchemaNameByRefPath(refPath string, spec *openapi3.T) (string, error) {
+	if spec == nil || spec.Components == nil {
 		return "", nil
 	}
 	pathElements := strings.Split(refPath, "/")
@@ -1015,19 +1091,19 @@ func findSchemaNameByRefPath(refPath string, spec *openapi3.T) (string, error) {
 	switch pathElements[2] {
 	case "schemas":
 		if schema, found := spec.Components.Schemas[propertyName]; found {
-			return renameSchema(propertyName, schema)
+			return g.renameSchema(propertyName, schema)
 		}
 	case "parameters":
 		if parameter, found := spec.Components.Parameters[propertyName]; found {
-			return renameParameter(propertyName, parameter)
+			return g.renameParameter(propertyName, parameter)
 		}
 	case "responses":
 		if response, found := spec.Components.Responses[propertyName]; found {
-			return renameResponse(propertyName, response)
+			return g.renameResponse(propertyName, response)
 		}
 	case "requestBodies":
 		if requestBody, found := spec.Components.RequestBodies[propertyName]; found {
-			return renameRequestBody(propertyName, requestBody)
+			return g.renameRequestBody(propertyName, requestBody)
 		}
 	}
 	return "", nil
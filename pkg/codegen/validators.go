@@ -0,0 +1,532 @@
+package codegen
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// maxValidatorDepth bounds how deeply the validation of values whose types
+// don't have a Validate method is inlined.
+const maxValidatorDepth = 8
+
+var namedGoTypeRE = regexp.MustCompile(`^([A-Za-z_][A-Za-z0-9_]*\.)?[A-Za-z_][A-Za-z0-9_]*$`)
+
+// ValidatorDefinition describes the Validate method generated for a type.
+type ValidatorDefinition struct {
+	// TypeName is the name of the type the method is generated for
+	TypeName string
+	// Body contains the statements which check the value of the type, `t`,
+	// against its schema, adding any violations to `errs`.
+	Body string
+}
+
+// ValidationPattern is a regular expression from the spec, which is compiled
+// once for all the validators which use it.
+type ValidationPattern struct {
+	// VarName is the name of the variable holding the compiled expression
+	VarName string
+	// Pattern is the regular expression
+	Pattern string
+}
+
+// validatorTypeNames are the names of the types generated along with the
+// validators.
+var validatorTypeNames = []string{"ValidationError", "ValidationErrors"}
+
+// GenerateValidators generates a Validate method for each of the given types
+// which can have one, which checks the value against the constraints of the
+// type's schema, along with the helpers those methods use. It fails if any of
+// the types has the name of one of the types generated for the validators.
+func (g *Generator) GenerateValidators(t *template.Template, types []TypeDefinition) (string, error) {
+	var validated []TypeDefinition
+	seen := map[string]bool{}
+	aliases := map[string]bool{}
+	for _, td := range types {
+		if td.IsAlias() {
+			aliases[td.TypeName] = true
+		}
+		if seen[td.TypeName] || !canHaveValidator(td) {
+			continue
+		}
+		seen[td.TypeName] = true
+		validated = append(validated, td)
+	}
+
+	if len(validated) == 0 {
+		return "", nil
+	}
+
+	for _, td := range types {
+		if StringInArray(td.TypeName, validatorTypeNames) {
+			return "", fmt.Errorf("the type %s conflicts with the type of the same name generated for the validators, rename it with `x-go-name`", td.TypeName)
+		}
+	}
+
+	b := &validatorBuilder{
+		g:         g,
+		validated: seen,
+		aliases:   aliases,
+		resolved:  map[*openapi3.Schema]*Schema{},
+		patterns:  map[string]string{},
+	}
+
+	context := struct {
+		Validators []ValidatorDefinition
+		Patterns   []ValidationPattern
+	}{}
+	for _, td := range validated {
+		body, err := b.typeBody(td)
+		if err != nil {
+			return "", fmt.Errorf("error generating validator for %s: %w", td.TypeName, err)
+		}
+		context.Validators = append(context.Validators, ValidatorDefinition{
+			TypeName: td.TypeName,
+			Body:     body,
+		})
+	}
+	for _, pattern := range SortedMapKeys(b.patterns) {
+		context.Patterns = append(context.Patterns, ValidationPattern{
+			VarName: b.patterns[pattern],
+			Pattern: pattern,
+		})
+	}
+	sort.Slice(context.Patterns, func(i, j int) bool {
+		return context.Patterns[i].VarName < context.Patterns[j].VarName
+	})
+
+	return GenerateTemplates([]string{"validators.tmpl"}, t, context)
+}
+
+// canHaveValidator returns whether a Validate method can be declared on the
+// type. Methods can't be declared on aliases, as they may refer to types in
+// other packages, nor on pointer and interface types.
+func canHaveValidator(td TypeDefinition) bool {
+	if td.IsAlias() {
+		return false
+	}
+	decl := td.Schema.TypeDecl()
+	return decl != "" && !strings.HasPrefix(decl, "*") && !strings.HasPrefix(decl, "interface")
+}
+
+// validatorBuilder builds the bodies of the Validate methods.
+type validatorBuilder struct {
+	g *Generator
+	// validated contains the names of the types which have a Validate method
+	validated map[string]bool
+	// aliases contains the names of the type aliases defined in the package
+	aliases map[string]bool
+	// resolved caches the Go schemas of referenced OpenAPI schemas
+	resolved map[*openapi3.Schema]*Schema
+	// patterns maps the regular expressions used to their variable names
+	patterns map[string]string
+	// vars counts the variables declared in the current method, to keep
+	// their names unique
+	vars int
+}
+
+func (b *validatorBuilder) typeBody(td TypeDefinition) (string, error) {
+	b.vars = 0
+
+	var w strings.Builder
+	expr := "t"
+	if decl := td.Schema.TypeDecl(); isNamedGoType(decl) {
+		// Convert to the underlying named type, whose methods aren't
+		// inherited by the type definition.
+		expr = decl + "(t)"
+	}
+	if err := b.value(&w, expr, td.Schema, `""`, 0); err != nil {
+		return "", err
+	}
+	return w.String(), nil
+}
+
+// value writes the checks of the Go expression expr, whose type is described
+// by s, where path is the Go expression of its JSON pointer.
+func (b *validatorBuilder) value(w *strings.Builder, expr string, s Schema, path string, depth int) error {
+	if depth > maxValidatorDepth {
+		return nil
+	}
+	decl := s.TypeDecl()
+
+	switch {
+	case isNamedGoType(decl):
+		if b.validated[decl] {
+			// Pointers have the methods of the types they point to
+			fmt.Fprintf(w, "errs.addNested(%s, %s.Validate())\n", path, strings.TrimPrefix(expr, "*"))
+			return nil
+		}
+
+		// The constraints of an alias's schema are checked here. Other types
+		// may have a Validate method which we don't know about, for instance
+		// when they're defined in another package, so it's used if it exists.
+		var fallback strings.Builder
+		resolved, err := b.resolve(s.OAPISchema)
+		if err != nil {
+			return err
+		}
+		if resolved != nil {
+			if err := b.value(&fallback, expr, *resolved, path, depth+1); err != nil {
+				return err
+			}
+		}
+
+		if b.aliases[decl] {
+			w.WriteString(fallback.String())
+			return nil
+		}
+
+		fmt.Fprintf(w, "if v, ok := interface{}(%s).(interface{ Validate() error }); ok {\n", expr)
+		fmt.Fprintf(w, "errs.addNested(%s, v.Validate())\n", path)
+		if fallback.Len() > 0 {
+			fmt.Fprintf(w, "} else {\n%s", fallback.String())
+		}
+		fmt.Fprintf(w, "}\n")
+
+	case strings.HasPrefix(decl, "struct {"):
+		if strings.HasPrefix(expr, "*") {
+			expr = "(" + expr + ")"
+		}
+		if err := b.properties(w, expr, s.Properties, path, depth); err != nil {
+			return err
+		}
+		if s.HasAdditionalProperties && s.AdditionalPropertiesType != nil {
+			if err := b.mapValues(w, expr+".AdditionalProperties", *s.AdditionalPropertiesType, path, depth); err != nil {
+				return err
+			}
+		}
+		if s.SealedUnion {
+			fmt.Fprintf(w, "if v, ok := %s.Value.(interface{ Validate() error }); ok {\n", expr)
+			fmt.Fprintf(w, "errs.addNested(%s, v.Validate())\n}\n", path)
+		}
+
+	case s.ArrayType != nil:
+		b.arrayConstraints(w, expr, s.OAPISchema, path)
+
+		i, v := b.newVar("i"), b.newVar("v")
+		var items strings.Builder
+		if err := b.value(&items, v, *s.ArrayType, joinPathExpr(path, "strconv.Itoa("+i+")"), depth+1); err != nil {
+			return err
+		}
+		if items.Len() > 0 {
+			fmt.Fprintf(w, "for %s, %s := range %s {\n%s}\n", i, v, expr, items.String())
+		}
+
+	case strings.HasPrefix(decl, "map[string]") && s.AdditionalPropertiesType != nil:
+		if err := b.mapValues(w, expr, *s.AdditionalPropertiesType, path, depth); err != nil {
+			return err
+		}
+
+	default:
+		b.primitiveConstraints(w, expr, decl, s, path)
+	}
+	return nil
+}
+
+// properties writes the checks of the fields of the struct expr.
+func (b *validatorBuilder) properties(w *strings.Builder, expr string, props []Property, path string, depth int) error {
+	for _, p := range props {
+		// Ignored fields aren't part of the JSON representation
+		if extension, ok := p.Extensions[extPropGoJsonIgnore]; ok {
+			if ignore, err := extParseGoJsonIgnore(extension); err == nil && ignore {
+				continue
+			}
+		}
+		// Determine the type of the field as GenFieldsFromProperties does
+		if extension, ok := p.Extensions[extPropGoTypeSkipOptionalPointer]; ok {
+			if skipOptionalPointer, err := extParsePropGoTypeSkipOptionalPointer(extension); err == nil {
+				p.Schema.SkipOptionalPointer = skipOptionalPointer
+			}
+		}
+
+		field := expr + "." + p.GoFieldName()
+		fieldPath := joinPath(path, "/"+escapeJSONPointerToken(p.JsonFieldName))
+		typeDef := p.GoTypeDef()
+
+		var checks strings.Builder
+		switch {
+		case strings.HasPrefix(typeDef, "nullable.Nullable["):
+			if p.Required && !p.ReadOnly && !p.WriteOnly {
+				fmt.Fprintf(w, "if !%s.IsSpecified() {\nerrs.add(%s, \"is required\")\n}\n", field, fieldPath)
+			}
+			v := b.newVar("v")
+			if err := b.value(&checks, v, p.Schema, fieldPath, depth+1); err != nil {
+				return err
+			}
+			if checks.Len() > 0 {
+				fmt.Fprintf(w, "if %s, err := %s.Get(); err == nil {\n%s}\n", v, field, checks.String())
+			}
+
+		case strings.HasPrefix(typeDef, "*"):
+			if err := b.value(&checks, "*"+field, p.Schema, fieldPath, depth+1); err != nil {
+				return err
+			}
+			// Read only and write only properties are only required in
+			// one direction, so can't be checked here.
+			if p.Required && !p.Nullable && !p.ReadOnly && !p.WriteOnly {
+				fmt.Fprintf(w, "if %s == nil {\nerrs.add(%s, \"is required\")\n}", field, fieldPath)
+				if checks.Len() > 0 {
+					fmt.Fprintf(w, " else {\n%s}", checks.String())
+				}
+				fmt.Fprintf(w, "\n")
+			} else if checks.Len() > 0 {
+				fmt.Fprintf(w, "if %s != nil {\n%s}\n", field, checks.String())
+			}
+
+		default:
+			if err := b.value(w, field, p.Schema, fieldPath, depth+1); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// mapValues writes the checks of the values of the map expr.
+func (b *validatorBuilder) mapValues(w *strings.Builder, expr string, s Schema, path string, depth int) error {
+	k, v := b.newVar("k"), b.newVar("v")
+	valuePath := joinPathExpr(path, "validationPointerToken("+k+")")
+
+	var checks strings.Builder
+	if s.OAPISchema != nil && s.OAPISchema.Nullable {
+		// Nullable values are stored as pointers
+		var inner strings.Builder
+		if err := b.value(&inner, "*"+v, s, valuePath, depth+1); err != nil {
+			return err
+		}
+		if inner.Len() > 0 {
+			fmt.Fprintf(&checks, "if %s != nil {\n%s}\n", v, inner.String())
+		}
+	} else if err := b.value(&checks, v, s, valuePath, depth+1); err != nil {
+		return err
+	}
+
+	if checks.Len() > 0 {
+		fmt.Fprintf(w, "for %s, %s := range %s {\n%s}\n", k, v, expr, checks.String())
+	}
+	return nil
+}
+
+// arrayConstraints writes the checks of the length and uniqueness of the
+// items of the slice expr.
+func (b *validatorBuilder) arrayConstraints(w *strings.Builder, expr string, schema *openapi3.Schema, path string) {
+	if schema == nil {
+		return
+	}
+	if schema.MinItems > 0 {
+		fmt.Fprintf(w, "if len(%s) < %d {\nerrs.add(%s, %q)\n}\n",
+			expr, schema.MinItems, path, fmt.Sprintf("must have at least %d %s", schema.MinItems, plural(schema.MinItems, "item")))
+	}
+	if schema.MaxItems != nil {
+		fmt.Fprintf(w, "if len(%s) > %d {\nerrs.add(%s, %q)\n}\n",
+			expr, *schema.MaxItems, path, fmt.Sprintf("must have at most %d %s", *schema.MaxItems, plural(*schema.MaxItems, "item")))
+	}
+	if schema.UniqueItems {
+		fmt.Fprintf(w, "if validationHasDuplicates(%s) {\nerrs.add(%s, %q)\n}\n",
+			expr, path, "must not contain duplicate items")
+	}
+}
+
+// primitiveConstraints writes the checks of the string, number or enum expr,
+// whose Go type is decl.
+func (b *validatorBuilder) primitiveConstraints(w *strings.Builder, expr string, decl string, s Schema, path string) {
+	schema := s.OAPISchema
+	if schema == nil {
+		return
+	}
+
+	switch {
+	case decl == "string":
+		if schema.MinLength > 0 {
+			fmt.Fprintf(w, "if utf8.RuneCountInString(string(%s)) < %d {\nerrs.add(%s, %q)\n}\n",
+				expr, schema.MinLength, path, fmt.Sprintf("must be at least %d %s long", schema.MinLength, plural(schema.MinLength, "character")))
+		}
+		if schema.MaxLength != nil {
+			fmt.Fprintf(w, "if utf8.RuneCountInString(string(%s)) > %d {\nerrs.add(%s, %q)\n}\n",
+				expr, *schema.MaxLength, path, fmt.Sprintf("must be at most %d %s long", *schema.MaxLength, plural(*schema.MaxLength, "character")))
+		}
+		if schema.Pattern != "" {
+			if _, err := regexp.Compile(schema.Pattern); err != nil {
+				fmt.Fprintf(w, "// The pattern %q isn't supported by the regexp package, so isn't checked\n", schema.Pattern)
+			} else {
+				fmt.Fprintf(w, "if !%s.MatchString(string(%s)) {\nerrs.add(%s, %q)\n}\n",
+					b.pattern(schema.Pattern), expr, path, fmt.Sprintf("must match the pattern %q", schema.Pattern))
+			}
+		}
+
+	case isNumericGoType(decl):
+		// The constraints are compared as float64, with float32 values
+		// converted via their shortest decimal representation, so that a
+		// float32 of 0.1 satisfies `maximum: 0.1`.
+		value := fmt.Sprintf("float64(%s)", expr)
+		if decl == "float32" {
+			value = fmt.Sprintf("validationFloat32(float32(%s))", expr)
+		}
+		if schema.Min != nil {
+			op, desc := "<", ">="
+			if schema.ExclusiveMin {
+				op, desc = "<=", ">"
+			}
+			min := formatFloat(*schema.Min)
+			fmt.Fprintf(w, "if %s %s %s {\nerrs.add(%s, %q)\n}\n",
+				value, op, min, path, fmt.Sprintf("must be %s %s", desc, min))
+		}
+		if schema.Max != nil {
+			op, desc := ">", "<="
+			if schema.ExclusiveMax {
+				op, desc = ">=", "<"
+			}
+			max := formatFloat(*schema.Max)
+			fmt.Fprintf(w, "if %s %s %s {\nerrs.add(%s, %q)\n}\n",
+				value, op, max, path, fmt.Sprintf("must be %s %s", desc, max))
+		}
+		if schema.MultipleOf != nil && *schema.MultipleOf > 0 {
+			multipleOf := formatFloat(*schema.MultipleOf)
+			fmt.Fprintf(w, "if !validationIsMultipleOf(%s, %s) {\nerrs.add(%s, %q)\n}\n",
+				value, multipleOf, path, fmt.Sprintf("must be a multiple of %s", multipleOf))
+		}
+
+	default:
+		return
+	}
+
+	if len(s.EnumValues) > 0 {
+		values := enumValuesInOrder(s)
+		cases := make([]string, len(values))
+		for i, v := range values {
+			if decl == "string" {
+				v = strconv.Quote(v)
+			}
+			cases[i] = v
+		}
+		fmt.Fprintf(w, "switch %s {\ncase %s:\ndefault:\nerrs.add(%s, %q)\n}\n",
+			expr, strings.Join(cases, ", "), path, "must be one of "+strings.Join(cases, ", "))
+	}
+}
+
+// resolve returns the Go schema of an OpenAPI schema which is referred to
+// by an alias, when its constraints can be checked by the referring type.
+func (b *validatorBuilder) resolve(schema *openapi3.Schema) (*Schema, error) {
+	if schema == nil || len(schema.Enum) > 0 || schema.AllOf != nil || schema.AnyOf != nil || schema.OneOf != nil {
+		return nil, nil
+	}
+	if _, ok := schema.Extensions[extPropGoType]; ok {
+		return nil, nil
+	}
+	if !schema.Type.Is("string") && !schema.Type.Is("integer") && !schema.Type.Is("number") && !schema.Type.Is("array") {
+		return nil, nil
+	}
+
+	if resolved, ok := b.resolved[schema]; ok {
+		return resolved, nil
+	}
+	// Record that this schema is being resolved, in case it refers to itself
+	b.resolved[schema] = nil
+
+	resolved, err := b.g.GenerateGoSchema(openapi3.NewSchemaRef("", schema), nil)
+	if err != nil {
+		return nil, err
+	}
+	if isNamedGoType(resolved.TypeDecl()) {
+		return nil, nil
+	}
+	// Any types generated for the items or values would be named after the
+	// alias, rather than the path used here, so they aren't checked.
+	if resolved.ArrayType != nil && resolved.ArrayType.RefType != "" {
+		resolved.ArrayType = &Schema{GoType: "interface{}"}
+	}
+	if resolved.AdditionalPropertiesType != nil && resolved.AdditionalPropertiesType.RefType != "" {
+		resolved.AdditionalPropertiesType = &Schema{GoType: "interface{}"}
+	}
+	b.resolved[schema] = &resolved
+	return &resolved, nil
+}
+
+func (b *validatorBuilder) pattern(pattern string) string {
+	if name, ok := b.patterns[pattern]; ok {
+		return name
+	}
+	name := fmt.Sprintf("validationPattern%d", len(b.patterns))
+	b.patterns[pattern] = name
+	return name
+}
+
+func (b *validatorBuilder) newVar(prefix string) string {
+	name := fmt.Sprintf("%s%d", prefix, b.vars)
+	b.vars++
+	return name
+}
+
+// isNamedGoType returns whether decl is the name of a type, which isn't
+// one of Go's predeclared types, such as `Pet` or `openapi_types.Date`.
+func isNamedGoType(decl string) bool {
+	return namedGoTypeRE.MatchString(decl) && !IsPredeclaredGoIdentifier(decl)
+}
+
+func isNumericGoType(decl string) bool {
+	switch decl {
+	case "int", "int8", "int16", "int32", "int64",
+		"uint", "uint8", "uint16", "uint32", "uint64",
+		"float32", "float64":
+		return true
+	}
+	return false
+}
+
+// enumValuesInOrder returns the values of an enum, in the order they're
+// declared in the spec.
+func enumValuesInOrder(s Schema) []string {
+	remaining := map[string]bool{}
+	for _, v := range s.EnumValues {
+		remaining[v] = true
+	}
+
+	var values []string
+	if s.OAPISchema != nil {
+		for _, e := range s.OAPISchema.Enum {
+			v := fmt.Sprintf("%v", e)
+			if remaining[v] {
+				values = append(values, v)
+				delete(remaining, v)
+			}
+		}
+	}
+	return append(values, SortedMapKeys(remaining)...)
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+// escapeJSONPointerToken escapes a reference token of a JSON pointer, as
+// described in RFC 6901.
+func escapeJSONPointerToken(token string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(token)
+}
+
+// joinPath appends the literal suffix to the Go string expression path.
+func joinPath(path string, suffix string) string {
+	if prefix, err := strconv.Unquote(path); err == nil {
+		return strconv.Quote(prefix + suffix)
+	}
+	return path + " + " + strconv.Quote(suffix)
+}
+
+// joinPathExpr appends a reference token, given by the Go string expression
+// token, to the Go string expression path.
+func joinPathExpr(path string, token string) string {
+	return joinPath(path, "/") + " + " + token
+}
+
+// plural returns noun, pluralized unless n is 1.
+func plural(n uint64, noun string) string {
+	if n == 1 {
+		return noun
+	}
+	return noun + "s"
+}
@@ -0,0 +1,139 @@
+package codegen
+
+import (
+	"go/format"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oapi-codegen/oapi-codegen/v2/pkg/util"
+)
+
+func TestGenerateValidators(t *testing.T) {
+	opts := Configuration{
+		PackageName: "api",
+		Generate: GenerateOptions{
+			Models: true,
+		},
+		OutputOptions: OutputOptions{
+			GenerateValidators: true,
+		},
+	}
+
+	swagger, err := util.LoadSwagger("test_specs/validators.yaml")
+	require.NoError(t, err)
+
+	code, err := Generate(swagger, opts)
+	require.NoError(t, err)
+
+	// Check that we have valid (formattable) code:
+	_, err = format.Source([]byte(code))
+	assert.NoError(t, err)
+
+	// Helpers are generated once
+	assert.Contains(t, code, "type ValidationErrors []ValidationError")
+	assert.Contains(t, code, `validationPattern0 = regexp.MustCompile("^[A-Za-z ]+$")`)
+
+	// Structs, including the types of operations, and enums are validated
+	assert.Contains(t, code, "func (t Pet) Validate() error {")
+	assert.Contains(t, code, "func (t AddPetJSONBody) Validate() error {")
+	assert.Contains(t, code, "func (t AddPetParams) Validate() error {")
+	assert.Contains(t, code, "func (t Color) Validate() error {")
+	assert.Contains(t, code, `errs.add("", "must be one of \"red\", \"green\", \"blue\"")`)
+	// Methods can't be declared on aliases, so their constraints are checked
+	// where they're used
+	assert.NotContains(t, code, "func (t Name) Validate() error {")
+	assert.Contains(t, code, `if !validationPattern0.MatchString(string(t.Name)) {
+		errs.add("/name", "must match the pattern \"^[A-Za-z ]+$\"")
+	}`)
+
+	// Numeric constraints
+	assert.Contains(t, code, `if float64(t.Age) >= 50 {
+		errs.add("/age", "must be < 50")
+	}`)
+	assert.Contains(t, code, `if !validationIsMultipleOf(validationFloat32(float32(*t.Weight)), 0.5) {`)
+
+	// Array constraints, and the items of arrays and maps
+	assert.Contains(t, code, `if validationHasDuplicates(*t.Tags) {`)
+	assert.Contains(t, code, `errs.add("/tags", "must have at least 1 item")`)
+	assert.Contains(t, code, `errs.addNested("/friends/"+strconv.Itoa(i0), v1.Validate())`)
+	assert.Contains(t, code, `errs.add("/labels/"+validationPointerToken(k2), "must be at least 2 characters long")`)
+
+	// Nested objects, and escaped property names
+	assert.Contains(t, code, `errs.addNested("/owner", t.Owner.Validate())`)
+	assert.Contains(t, code, `if utf8.RuneCountInString(string((*t.Address).Street)) < 3 {`)
+	assert.Contains(t, code, `// The pattern "(?=lookahead)" isn't supported by the regexp package, so isn't checked`)
+
+	// Required nullable properties may be null
+	assert.NotContains(t, code, `errs.add("/note", "is required")`)
+}
+
+func TestGenerateValidatorsNullableType(t *testing.T) {
+	opts := Configuration{
+		PackageName: "api",
+		Generate: GenerateOptions{
+			Models: true,
+		},
+		OutputOptions: OutputOptions{
+			GenerateValidators: true,
+			NullableType:       true,
+		},
+	}
+
+	swagger, err := util.LoadSwagger("test_specs/validators.yaml")
+	require.NoError(t, err)
+
+	code, err := Generate(swagger, opts)
+	require.NoError(t, err)
+
+	// Check that we have valid (formattable) code:
+	_, err = format.Source([]byte(code))
+	assert.NoError(t, err)
+
+	// Required nullable properties may be null, but must be specified
+	assert.Contains(t, code, `if !t.Note.IsSpecified() {
+		errs.add("/note", "is required")
+	}
+	if v0, err := t.Note.Get(); err == nil {
+		if utf8.RuneCountInString(string(v0)) > 4 {`)
+}
+
+func TestGenerateValidatorsDisabled(t *testing.T) {
+	opts := Configuration{
+		PackageName: "api",
+		Generate: GenerateOptions{
+			Models: true,
+		},
+	}
+
+	swagger, err := util.LoadSwagger("test_specs/validators.yaml")
+	require.NoError(t, err)
+
+	code, err := Generate(swagger, opts)
+	require.NoError(t, err)
+
+	assert.NotContains(t, code, "Validate() error")
+	assert.NotContains(t, code, "ValidationErrors")
+}
+
+func TestGenerateValidatorsConflict(t *testing.T) {
+	opts := Configuration{
+		PackageName: "api",
+		Generate: GenerateOptions{
+			Models: true,
+		},
+		OutputOptions: OutputOptions{
+			GenerateValidators: true,
+			SkipPrune:          true,
+		},
+	}
+
+	swagger, err := util.LoadSwagger("test_specs/validators.yaml")
+	require.NoError(t, err)
+	swagger.Components.Schemas["ValidationError"] = swagger.Components.Schemas["Pet"]
+
+	_, err = Generate(swagger, opts)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "the type ValidationError conflicts with the type of the same name generated for the validators")
+}
@@ -0,0 +1,207 @@
+package codegen
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// The suffixes of the variants of the schemas with readOnly or writeOnly
+// properties. The request variant doesn't have the readOnly properties, and
+// the response variant doesn't have the writeOnly properties.
+const (
+	requestVariant  = "Request"
+	responseVariant = "Response"
+)
+
+const componentSchemasPrefix = "#/components/schemas/"
+
+// readWriteVariantSchemas returns the references of the component schemas
+// which have readOnly or writeOnly properties, directly or within the schemas
+// they refer to, so need request and response variants.
+func readWriteVariantSchemas(spec *openapi3.T, excludeSchemas []string) map[string]bool {
+	variants := map[string]bool{}
+	if spec.Components == nil {
+		return variants
+	}
+
+	refs := map[string]map[string]bool{}
+	for name, schema := range spec.Components.Schemas {
+		if StringInArray(name, excludeSchemas) {
+			continue
+		}
+		refs[componentSchemasPrefix+name] = map[string]bool{}
+		if collectReadWriteOnly(schema, refs[componentSchemasPrefix+name], map[*openapi3.Schema]bool{}) {
+			variants[componentSchemasPrefix+name] = true
+		}
+	}
+
+	// The schemas which refer to schemas with variants have them too
+	for changed := true; changed; {
+		changed = false
+		for ref, schemaRefs := range refs {
+			if variants[ref] {
+				continue
+			}
+			for schemaRef := range schemaRefs {
+				if variants[schemaRef] {
+					variants[ref] = true
+					changed = true
+					break
+				}
+			}
+		}
+	}
+	return variants
+}
+
+// collectReadWriteOnly returns whether the schema has readOnly or writeOnly
+// properties, not counting those of the component schemas it refers to, which
+// are added to refs.
+func collectReadWriteOnly(sref *openapi3.SchemaRef, refs map[string]bool, visited map[*openapi3.Schema]bool) bool {
+	if sref == nil {
+		return false
+	}
+	if sref.Ref != "" {
+		if strings.HasPrefix(sref.Ref, componentSchemasPrefix) {
+			refs[sref.Ref] = true
+		}
+		return false
+	}
+	schema := sref.Value
+	if schema == nil || visited[schema] {
+		return false
+	}
+	visited[schema] = true
+
+	found := false
+	for _, p := range schema.Properties {
+		if p.Value != nil && (p.Value.ReadOnly || p.Value.WriteOnly) {
+			found = true
+		}
+		if collectReadWriteOnly(p, refs, visited) {
+			found = true
+		}
+	}
+	nested := []*openapi3.SchemaRef{schema.Items, schema.AdditionalProperties.Schema}
+	nested = append(nested, schema.AllOf...)
+	nested = append(nested, schema.AnyOf...)
+	nested = append(nested, schema.OneOf...)
+	for _, n := range nested {
+		if collectReadWriteOnly(n, refs, visited) {
+			found = true
+		}
+	}
+	return found
+}
+
+// withVariant returns a Generator which generates the types of the given
+// variant, which refer to the variants of the schemas which have them, and
+// omit the properties which aren't part of the variant.
+func (g *Generator) withVariant(variant string) *Generator {
+	if len(g.variants) == 0 {
+		return g
+	}
+	v := *g
+	v.variant = variant
+	return &v
+}
+
+// variantGoType returns the Go type of the variant being generated of the
+// schema refPath refers to, whose Go type is goType.
+func (g *Generator) variantGoType(refPath string, goType string) string {
+	if g.variant != "" && g.variants[refPath] {
+		return goType + g.variant
+	}
+	return goType
+}
+
+// skipsProperty returns whether the property isn't part of the variant being
+// generated.
+func (g *Generator) skipsProperty(p *openapi3.Schema) bool {
+	switch g.variant {
+	case requestVariant:
+		return p.ReadOnly
+	case responseVariant:
+		return p.WriteOnly
+	}
+	return false
+}
+
+// variantPath returns the path used to name the type defined for the schema
+// at path, within a variant of a component schema. The types which are the
+// same in the variants as in the schema are shared with it, and the others are
+// named after the variant.
+func (g *Generator) variantPath(path []string, sref *openapi3.SchemaRef) []string {
+	if g.variant == "" || len(path) == 0 || !g.variants[componentSchemasPrefix+path[0]] {
+		return path
+	}
+	refs := map[string]bool{}
+	differs := collectReadWriteOnly(sref, refs, map[*openapi3.Schema]bool{})
+	for ref := range refs {
+		if g.variants[ref] {
+			differs = true
+		}
+	}
+	if !differs {
+		return path
+	}
+	return append([]string{path[0] + g.variant}, path[1:]...)
+}
+
+// generateVariantTypes generates the request and response variants of the
+// component schema, given its type definitions, along with the types defined
+// for their properties which aren't shared with the schema.
+func (g *Generator) generateVariantTypes(schemaName string, schemaRef *openapi3.SchemaRef, types []TypeDefinition) ([]TypeDefinition, error) {
+	shared := map[string]bool{}
+	for _, td := range types {
+		shared[td.TypeName] = true
+	}
+
+	var variants []TypeDefinition
+	for _, variant := range []string{requestVariant, responseVariant} {
+		vg := g.withVariant(variant)
+		goSchema, err := vg.GenerateGoSchema(schemaRef, []string{schemaName})
+		if err != nil {
+			return nil, fmt.Errorf("error generating %s variant: %w", variant, err)
+		}
+
+		variants = append(variants, TypeDefinition{
+			JsonName:  schemaName,
+			TypeName:  types[0].TypeName + variant,
+			Schema:    goSchema,
+			generator: vg,
+		})
+		for _, td := range goSchema.AdditionalTypes {
+			if !shared[td.TypeName] {
+				variants = append(variants, td)
+			}
+		}
+	}
+	return variants, nil
+}
+
+// checkVariantConflicts returns an error if the name of a variant conflicts
+// with that of the response type of an operation in the client with responses.
+func (g *Generator) checkVariantConflicts(ops []OperationDefinition) error {
+	if !g.opts.Generate.Client {
+		return nil
+	}
+	responseTypes := map[string]bool{}
+	for _, op := range ops {
+		responseTypes[op.OperationId+g.responseTypeSuffix] = true
+	}
+	for _, ref := range SortedMapKeys(g.variants) {
+		goType, err := g.localRefPathToGoType(ref)
+		if err != nil {
+			return err
+		}
+		for _, variant := range []string{requestVariant, responseVariant} {
+			if responseTypes[goType+variant] {
+				return fmt.Errorf("the %s variant of %s conflicts with the response type of an operation of the same name, set `output-options.response-type-suffix` to rename the response types", goType+variant, goType)
+			}
+		}
+	}
+	return nil
+}
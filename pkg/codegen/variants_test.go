@@ -0,0 +1,133 @@
+package codegen
+
+import (
+	"go/format"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const variantsSpec = `
+openapi: "3.0.0"
+info:
+  version: 1.0.0
+  title: Variants
+paths:
+  /pets:
+    post:
+      operationId: addPet
+      requestBody:
+        required: true
+        content:
+          application/json:
+            schema:
+              $ref: '#/components/schemas/Pet'
+      responses:
+        '200':
+          description: ok
+          content:
+            application/json:
+              schema:
+                type: array
+                items:
+                  $ref: '#/components/schemas/Pet'
+components:
+  schemas:
+    Pet:
+      type: object
+      required: [id, name, password]
+      properties:
+        id:
+          type: integer
+          readOnly: true
+        name:
+          type: string
+        password:
+          type: string
+          writeOnly: true
+        status:
+          type: string
+          enum: [available, sold]
+        meta:
+          type: object
+          properties:
+            createdAt:
+              type: string
+              readOnly: true
+          additionalProperties:
+            type: string
+    Owner:
+      type: object
+      properties:
+        pet:
+          $ref: '#/components/schemas/Pet'
+    Toy:
+      type: object
+      properties:
+        name:
+          type: string
+`
+
+func TestGenerateReadWriteVariants(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(variantsSpec))
+	require.NoError(t, err)
+
+	code, err := Generate(swagger, Configuration{
+		PackageName:   "api",
+		Generate:      GenerateOptions{Models: true, Client: true, ChiServer: true, Strict: true},
+		OutputOptions: OutputOptions{SkipPrune: true, GenerateReadWriteVariants: true},
+	})
+	require.NoError(t, err)
+	_, err = format.Source([]byte(code))
+	require.NoError(t, err)
+
+	// The schema itself is unchanged
+	assert.Contains(t, code, "\tId       *int       `json:\"id,omitempty\"`\n")
+
+	// The required properties of the variants aren't pointers
+	assert.Contains(t, code, "type PetRequest struct {\n\tMeta     *PetRequest_Meta `json:\"meta,omitempty\"`\n\tName     string           `json:\"name\"`\n\tPassword string           `json:\"password\"`\n\tStatus   *PetStatus       `json:\"status,omitempty\"`\n}")
+	assert.Contains(t, code, "type PetResponse struct {\n\tId     int               `json:\"id\"`\n\tMeta   *PetResponse_Meta `json:\"meta,omitempty\"`\n\tName   string            `json:\"name\"`\n\tStatus *PetStatus        `json:\"status,omitempty\"`\n}")
+	assert.Contains(t, code, "type PetRequest_Meta struct {\n\tAdditionalProperties map[string]string `json:\"-\"`\n}")
+
+	// The schemas referring to schemas with variants have them too
+	assert.Contains(t, code, "type OwnerRequest struct {\n\tPet *PetRequest `json:\"pet,omitempty\"`\n}")
+	assert.Contains(t, code, "type OwnerResponse struct {\n\tPet *PetResponse `json:\"pet,omitempty\"`\n}")
+	assert.NotContains(t, code, "type ToyRequest")
+
+	// The bodies and responses use the variants
+	assert.Contains(t, code, "type AddPetJSONRequestBody = PetRequest")
+	assert.Contains(t, code, "\tJSON200      *[]PetResponse\n")
+	assert.Contains(t, code, "type AddPet200JSONResponse []PetResponse")
+}
+
+func TestGenerateReadWriteVariantsDisabled(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(variantsSpec))
+	require.NoError(t, err)
+
+	code, err := Generate(swagger, Configuration{
+		PackageName:   "api",
+		Generate:      GenerateOptions{Models: true, Client: true},
+		OutputOptions: OutputOptions{SkipPrune: true},
+	})
+	require.NoError(t, err)
+
+	assert.NotContains(t, code, "type PetRequest")
+	assert.Contains(t, code, "type AddPetJSONRequestBody = Pet")
+	assert.Contains(t, code, "\tJSON200      *[]Pet\n")
+}
+
+func TestReadWriteVariantConflicts(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(variantsSpec))
+	require.NoError(t, err)
+	swagger.Paths.Find("/pets").Post.OperationID = "pet"
+
+	_, err = Generate(swagger, Configuration{
+		PackageName:   "api",
+		Generate:      GenerateOptions{Models: true, Client: true},
+		OutputOptions: OutputOptions{SkipPrune: true, GenerateReadWriteVariants: true},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "the PetResponse variant of Pet conflicts with the response type of an operation of the same name")
+}
@@ -0,0 +1,276 @@
+package codegen
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// keywordWebhooks holds the webhooks of an OpenAPI 3.1 spec, which kin-openapi
+// leaves undecoded in the extensions of the spec
+const keywordWebhooks = "webhooks"
+
+// specWebhooks returns the webhooks of a spec by name. They're only available
+// once the spec has been normalized by normalizeOpenAPI31.
+func specWebhooks(spec *openapi3.T) map[string]*openapi3.PathItem {
+	webhooks, _ := spec.Extensions[keywordWebhooks].(map[string]*openapi3.PathItem)
+	return webhooks
+}
+
+// decodeWebhooks decodes the webhooks of an OpenAPI 3.1 spec, and stores them
+// back in the extensions of the spec as path items, which still serialize to
+// the same JSON. Any references within them are left unresolved.
+func decodeWebhooks(spec *openapi3.T) error {
+	raw, ok := spec.Extensions[keywordWebhooks]
+	if !ok {
+		return nil
+	}
+	if _, decoded := raw.(map[string]*openapi3.PathItem); decoded {
+		return nil
+	}
+
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return fmt.Errorf("invalid %s: %w", keywordWebhooks, err)
+	}
+	var webhooks map[string]*openapi3.PathItem
+	if err := json.Unmarshal(data, &webhooks); err != nil {
+		return fmt.Errorf("invalid %s: %w", keywordWebhooks, err)
+	}
+	spec.Extensions[keywordWebhooks] = webhooks
+	return nil
+}
+
+// resolveComponentRef resolves a local reference to a component other than a
+// schema, which kin-openapi left unresolved, as it does for those within
+// webhooks. References to schemas are resolved by updateDefRef.
+func resolveComponentRef(spec *openapi3.T, source interface{}) error {
+	components := spec.Components
+	if components == nil {
+		components = &openapi3.Components{}
+	}
+
+	var ref string
+	switch r := source.(type) {
+	case *openapi3.ParameterRef:
+		if r.Ref == "" || r.Value != nil {
+			return nil
+		}
+		if c := components.Parameters[componentName(r.Ref, "parameters")]; c != nil && c.Value != nil {
+			r.Value = c.Value
+			return nil
+		}
+		ref = r.Ref
+	case *openapi3.RequestBodyRef:
+		if r.Ref == "" || r.Value != nil {
+			return nil
+		}
+		if c := components.RequestBodies[componentName(r.Ref, "requestBodies")]; c != nil && c.Value != nil {
+			r.Value = c.Value
+			return nil
+		}
+		ref = r.Ref
+	case *openapi3.ResponseRef:
+		if r.Ref == "" || r.Value != nil {
+			return nil
+		}
+		if c := components.Responses[componentName(r.Ref, "responses")]; c != nil && c.Value != nil {
+			r.Value = c.Value
+			return nil
+		}
+		ref = r.Ref
+	case *openapi3.HeaderRef:
+		if r.Ref == "" || r.Value != nil {
+			return nil
+		}
+		if c := components.Headers[componentName(r.Ref, "headers")]; c != nil && c.Value != nil {
+			r.Value = c.Value
+			return nil
+		}
+		ref = r.Ref
+	case *openapi3.ExampleRef:
+		if r.Ref == "" || r.Value != nil {
+			return nil
+		}
+		if c := components.Examples[componentName(r.Ref, "examples")]; c != nil && c.Value != nil {
+			r.Value = c.Value
+			return nil
+		}
+		ref = r.Ref
+	case *openapi3.LinkRef:
+		if r.Ref == "" || r.Value != nil {
+			return nil
+		}
+		if c := components.Links[componentName(r.Ref, "links")]; c != nil && c.Value != nil {
+			r.Value = c.Value
+			return nil
+		}
+		ref = r.Ref
+	case *openapi3.CallbackRef:
+		if r.Ref == "" || r.Value != nil {
+			return nil
+		}
+		if c := components.Callbacks[componentName(r.Ref, "callbacks")]; c != nil && c.Value != nil {
+			r.Value = c.Value
+			return nil
+		}
+		ref = r.Ref
+	default:
+		return nil
+	}
+	return fmt.Errorf("unresolved reference %s", ref)
+}
+
+// componentName returns the name of the component of the given section of the
+// components which ref refers to, or an empty string if it doesn't refer to
+// one.
+func componentName(ref, section string) string {
+	name, ok := strings.CutPrefix(ref, "#/components/"+section+"/")
+	if !ok {
+		return ""
+	}
+	return unescapeJSONPointerToken(name)
+}
+
+// WebhookOperationDefinitions returns the operations of the webhooks of an
+// OpenAPI 3.1 spec, followed by those of the callbacks of the operations of
+// the spec's paths. Neither is served at a path of the spec, so their
+// definitions have no path parameters, and their Path is the name of the
+// webhook, or the expression of the callback, instead.
+//
+// Webhooks which don't have an operationId are named after their method and
+// name, and callbacks after their method, the operation they're a callback of
+// and their name.
+func (g *Generator) WebhookOperationDefinitions(swagger *openapi3.T, initialismOverrides bool) ([]OperationDefinition, error) {
+	var operations []OperationDefinition
+	if swagger == nil {
+		return operations, nil
+	}
+
+	var toCamelCaseFunc func(string) string
+	if initialismOverrides {
+		toCamelCaseFunc = ToCamelCaseWithInitialism
+	} else {
+		toCamelCaseFunc = ToCamelCase
+	}
+
+	// Callbacks may be shared between operations by reference, and should only
+	// be described once.
+	described := map[*openapi3.Operation]bool{}
+
+	describe := func(pathItem *openapi3.PathItem, path, defaultIDPath string) error {
+		globalParams, err := g.DescribeParameters(pathItem.Parameters, nil)
+		if err != nil {
+			return fmt.Errorf("error describing global parameters for %s: %s", path, err)
+		}
+
+		pathOps := pathItem.Operations()
+		for _, opName := range SortedMapKeys(pathOps) {
+			op := pathOps[opName]
+			if described[op] {
+				continue
+			}
+			described[op] = true
+
+			if op.OperationID == "" {
+				op.OperationID, err = g.generateDefaultOperationID(opName, defaultIDPath, toCamelCaseFunc)
+				if err != nil {
+					return fmt.Errorf("error generating default OperationID for %s/%s: %s",
+						opName, path, err)
+				}
+			} else {
+				op.OperationID = g.nameNormalizer(op.OperationID)
+			}
+			op.OperationID = typeNamePrefix(op.OperationID) + op.OperationID
+
+			opDef, err := g.describeOperation(swagger, pathItem, globalParams, opName, op, path, false)
+			if err != nil {
+				return err
+			}
+			operations = append(operations, *opDef)
+		}
+		return nil
+	}
+
+	webhooks := specWebhooks(swagger)
+	for _, name := range SortedMapKeys(webhooks) {
+		if webhooks[name] == nil {
+			continue
+		}
+		if err := describe(webhooks[name], name, name); err != nil {
+			return nil, fmt.Errorf("error describing webhook %s: %w", name, err)
+		}
+	}
+
+	if swagger.Paths == nil {
+		return operations, nil
+	}
+	for _, requestPath := range SortedMapKeys(swagger.Paths.Map()) {
+		pathOps := swagger.Paths.Value(requestPath).Operations()
+		for _, opName := range SortedMapKeys(pathOps) {
+			op := pathOps[opName]
+
+			parentID := op.OperationID
+			if parentID == "" {
+				var err error
+				parentID, err = g.generateDefaultOperationID(opName, requestPath, toCamelCaseFunc)
+				if err != nil {
+					return nil, fmt.Errorf("error generating default OperationID for %s/%s: %s",
+						opName, requestPath, err)
+				}
+			}
+
+			for _, callbackName := range SortedMapKeys(op.Callbacks) {
+				callback := op.Callbacks[callbackName]
+				if callback == nil || callback.Value == nil {
+					continue
+				}
+				expressions := callback.Value.Map()
+				for _, expression := range SortedMapKeys(expressions) {
+					if err := describe(expressions[expression], expression, parentID+"/"+callbackName); err != nil {
+						return nil, fmt.Errorf("error describing callback %s of %s: %w", callbackName, parentID, err)
+					}
+				}
+			}
+		}
+	}
+	return operations, nil
+}
+
+// GenerateWebhookClient generates a client which sends the requests of the
+// webhooks and callbacks to the URLs which they're registered at.
+func GenerateWebhookClient(t *template.Template, ops []OperationDefinition) (string, error) {
+	return GenerateTemplates([]string{"webhooks/webhook-client.tmpl"}, t, ops)
+}
+
+// GenerateWebhookServer generates a net/http server for receiving the requests
+// of the webhooks and callbacks, along with a strict server when opts enables
+// those.
+func GenerateWebhookServer(t *template.Template, ops []OperationDefinition, opts Configuration) (string, error) {
+	templates := []string{"webhooks/webhook-server.tmpl"}
+	if opts.Generate.Strict {
+		templates = append(templates, "webhooks/webhook-strict.tmpl")
+	}
+	return GenerateTemplates(templates, t, ops)
+}
+
+// checkWebhookOperationIDs returns an error if any of the webhooks or callbacks
+// has the same operationId as an operation of the paths, or as another
+// webhook or callback, as the types and functions generated for them would
+// have the same names.
+func checkWebhookOperationIDs(ops, webhookOps []OperationDefinition) error {
+	paths := map[string]string{}
+	for _, op := range ops {
+		paths[op.OperationId] = op.Path
+	}
+	for _, op := range webhookOps {
+		if path, ok := paths[op.OperationId]; ok {
+			return fmt.Errorf("the operationId %s of the webhook or callback %s conflicts with the operation of %s, please give them different operationIds", op.OperationId, op.Path, path)
+		}
+		paths[op.OperationId] = op.Path
+	}
+	return nil
+}
@@ -0,0 +1,150 @@
+package codegen
+
+import (
+	"go/format"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oapi-codegen/oapi-codegen/v2/pkg/util"
+)
+
+func TestWebhookOperationDefinitions(t *testing.T) {
+	swagger, err := util.LoadSwagger("test_specs/webhooks.yaml")
+	require.NoError(t, err)
+	require.NoError(t, normalizeOpenAPI31(swagger))
+
+	ops, err := defaultGenerator.WebhookOperationDefinitions(swagger, false)
+	require.NoError(t, err)
+	require.Len(t, ops, 3)
+
+	// Webhooks come first, ordered by name
+	assert.Equal(t, "NewPetWebhook", ops[0].OperationId)
+	assert.Equal(t, "POST", ops[0].Method)
+	assert.Equal(t, "newPet", ops[0].Path)
+	require.Len(t, ops[0].HeaderParams, 1)
+	assert.Equal(t, "X-Signature", ops[0].HeaderParams[0].ParamName)
+	require.Len(t, ops[0].Bodies, 1)
+	assert.Equal(t, "Pet", ops[0].Bodies[0].Schema.RefType)
+
+	assert.Equal(t, "DeletePetRemoved", ops[1].OperationId)
+	assert.Equal(t, "petRemoved", ops[1].Path)
+
+	// Followed by the callbacks of the paths
+	assert.Equal(t, "PostCreateSubscriptionOnEvent", ops[2].OperationId)
+	assert.Equal(t, "{$request.body#/callbackUrl}", ops[2].Path)
+	assert.Empty(t, ops[2].PathParams)
+}
+
+func TestGenerateWebhooks(t *testing.T) {
+	opts := Configuration{
+		PackageName: "api",
+		Generate: GenerateOptions{
+			Models:        true,
+			Strict:        true,
+			WebhookClient: true,
+			WebhookServer: true,
+		},
+	}
+
+	swagger, err := util.LoadSwagger("test_specs/webhooks.yaml")
+	require.NoError(t, err)
+
+	code, err := Generate(swagger, opts)
+	require.NoError(t, err)
+
+	_, err = format.Source([]byte(code))
+	require.NoError(t, err)
+
+	// Components used only by webhooks aren't pruned
+	assert.Contains(t, code, "type Pet struct {")
+	assert.Contains(t, code, "type NewPetWebhookJSONRequestBody = Pet")
+
+	// The client sends requests to the URL the webhook is registered at
+	assert.Contains(t, code, "type RequestEditorFn func(")
+	assert.Contains(t, code, "func (c *WebhookClient) NewPetWebhook(ctx context.Context, targetURL string, params *NewPetWebhookParams, body NewPetWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {")
+	assert.Contains(t, code, "func (c *WebhookClient) PostCreateSubscriptionOnEvent(ctx context.Context, targetURL string, body PostCreateSubscriptionOnEventJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {")
+
+	// The server has a handler per webhook and callback
+	assert.Contains(t, code, "NewPetWebhook(w http.ResponseWriter, r *http.Request, params NewPetWebhookParams)")
+	assert.Contains(t, code, "func (siw *WebhookServerInterfaceWrapper) DeletePetRemoved(w http.ResponseWriter, r *http.Request) {")
+	assert.Contains(t, code, "type InvalidParamFormatError struct {")
+	assert.Contains(t, code, "NewPetWebhook(ctx context.Context, request NewPetWebhookRequestObject) (NewPetWebhookResponseObject, error)")
+	assert.Contains(t, code, "func NewWebhookStrictHandler(ssi WebhookStrictServerInterface, middlewares []strictnethttp.StrictHTTPMiddlewareFunc) WebhookServerInterface {")
+
+	// No server or client is generated for the paths
+	assert.NotContains(t, code, "type ServerInterface interface")
+	assert.NotContains(t, code, "func NewClient(")
+}
+
+func TestGenerateWebhooksOperationIDConflict(t *testing.T) {
+	opts := Configuration{
+		PackageName: "api",
+		Generate: GenerateOptions{
+			Models:        true,
+			Client:        true,
+			WebhookClient: true,
+		},
+	}
+
+	swagger, err := util.LoadSwagger("test_specs/webhooks.yaml")
+	require.NoError(t, err)
+	swagger.Paths.Value("/subscriptions").Post.OperationID = "newPetWebhook"
+
+	_, err = Generate(swagger, opts)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "the operationId NewPetWebhook of the webhook or callback newPet conflicts with the operation of /subscriptions")
+}
+
+func TestGenerateWebhooksDisabled(t *testing.T) {
+	swagger, err := util.LoadSwagger("test_specs/webhooks.yaml")
+	require.NoError(t, err)
+
+	code, err := Generate(swagger, Configuration{
+		PackageName: "api",
+		Generate: GenerateOptions{
+			Models: true,
+			Client: true,
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Contains(t, code, "func (c *Client) CreateSubscription(")
+	assert.NotContains(t, code, "Webhook")
+	assert.NotContains(t, code, "PostCreateSubscriptionOnEvent")
+}
+
+func TestGenerateTagPackagesWebhooks(t *testing.T) {
+	opts := Configuration{
+		PackageName: "api",
+		Generate: GenerateOptions{
+			Models:        true,
+			WebhookClient: true,
+		},
+		OutputOptions: OutputOptions{
+			TagPackages: &TagPackagesOptions{
+				BaseImportPath: "example.com/api",
+			},
+		},
+	}
+
+	swagger, err := util.LoadSwagger("test_specs/webhooks.yaml")
+	require.NoError(t, err)
+
+	packages, err := GenerateTagPackages(swagger, opts)
+	require.NoError(t, err)
+	require.Len(t, packages, 3)
+
+	assert.Contains(t, packages[DefaultModelsPackage][TypesFileName], "type Pet struct {")
+
+	// Webhooks are generated in the default package
+	api := packages["api"]
+	assert.Contains(t, api[ClientFileName], "func (c *WebhookClient) NewPetWebhook(")
+	assert.NotContains(t, api[ClientFileName], "PostCreateSubscriptionOnEvent")
+
+	// Callbacks are generated alongside the operation they're a callback of
+	subscriptions := packages["subscriptions"]
+	assert.Contains(t, subscriptions[ClientFileName], "func (c *WebhookClient) PostCreateSubscriptionOnEvent(")
+	assert.NotContains(t, subscriptions[ClientFileName], "NewPetWebhook")
+}
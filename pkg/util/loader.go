@@ -15,6 +15,7 @@ func LoadSwagger(filePath string) (swagger *openapi3.T, err error) {
 
 	loader := openapi3.NewLoader()
 	loader.IsExternalRefsAllowed = true
+	loader.ReadFromURIFunc = readFromURIConvertingExclusiveBounds
 
 	u, err := url.Parse(filePath)
 	if err == nil && u.Scheme != "" && u.Host != "" {
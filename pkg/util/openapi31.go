@@ -0,0 +1,153 @@
+package util
+
+import (
+	"bytes"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"gopkg.in/yaml.v3"
+)
+
+// readFromURIConvertingExclusiveBounds reads a document as kin-openapi does by
+// default, converting any OpenAPI 3.1 exclusive bounds, which kin-openapi can't
+// parse, into their OpenAPI 3.0 equivalents.
+func readFromURIConvertingExclusiveBounds(loader *openapi3.Loader, location *url.URL) ([]byte, error) {
+	data, err := openapi3.DefaultReadFromURI(loader, location)
+	if err != nil {
+		return nil, err
+	}
+	return convertDocumentExclusiveBounds(data)
+}
+
+// convertDocumentExclusiveBounds converts the numeric `exclusiveMinimum` and
+// `exclusiveMaximum` of OpenAPI 3.1 (and JSON Schema) schemas in the JSON or
+// YAML document data into the boolean form used by OpenAPI 3.0, alongside a
+// `minimum` or `maximum`. Documents which don't contain any are returned
+// unchanged.
+func convertDocumentExclusiveBounds(data []byte) ([]byte, error) {
+	var node yaml.Node
+	if err := yaml.Unmarshal(data, &node); err != nil {
+		// Leave reporting the error to the loader
+		return data, nil
+	}
+
+	changed, err := convertExclusiveBounds(&node)
+	if err != nil {
+		return nil, err
+	}
+	if !changed {
+		return data, nil
+	}
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(&node); err != nil {
+		return nil, fmt.Errorf("failed to serialize document: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// valueKeywords are the keywords whose values are data, rather than schemas, so
+// aren't converted.
+var valueKeywords = map[string]bool{
+	"const":    true,
+	"default":  true,
+	"enum":     true,
+	"example":  true,
+	"examples": true,
+}
+
+func convertExclusiveBounds(node *yaml.Node) (bool, error) {
+	switch node.Kind {
+	case yaml.DocumentNode, yaml.SequenceNode:
+		changed := false
+		for _, n := range node.Content {
+			c, err := convertExclusiveBounds(n)
+			if err != nil {
+				return false, err
+			}
+			changed = changed || c
+		}
+		return changed, nil
+	case yaml.MappingNode:
+	default:
+		return false, nil
+	}
+
+	changed := false
+	for _, bound := range []struct{ exclusive, inclusive string }{
+		{"exclusiveMinimum", "minimum"},
+		{"exclusiveMaximum", "maximum"},
+	} {
+		c, err := convertExclusiveBound(node, bound.exclusive, bound.inclusive)
+		if err != nil {
+			return false, err
+		}
+		changed = changed || c
+	}
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if valueKeywords[node.Content[i].Value] {
+			continue
+		}
+		c, err := convertExclusiveBounds(node.Content[i+1])
+		if err != nil {
+			return false, err
+		}
+		changed = changed || c
+	}
+	return changed, nil
+}
+
+// convertExclusiveBound converts a numeric exclusive bound in the mapping node
+// to a boolean, moving its value into the inclusive bound, unless the
+// inclusive bound is already more restrictive.
+func convertExclusiveBound(node *yaml.Node, exclusive, inclusive string) (bool, error) {
+	exclusiveValue := mappingValue(node, exclusive)
+	if exclusiveValue == nil || exclusiveValue.Kind != yaml.ScalarNode ||
+		(exclusiveValue.Tag != "!!int" && exclusiveValue.Tag != "!!float") {
+		return false, nil
+	}
+	bound, err := strconv.ParseFloat(exclusiveValue.Value, 64)
+	if err != nil {
+		return false, fmt.Errorf("invalid %s %q: %w", exclusive, exclusiveValue.Value, err)
+	}
+
+	isExclusive := true
+	if inclusiveValue := mappingValue(node, inclusive); inclusiveValue != nil {
+		other, err := strconv.ParseFloat(inclusiveValue.Value, 64)
+		if err != nil {
+			return false, fmt.Errorf("invalid %s %q: %w", inclusive, inclusiveValue.Value, err)
+		}
+		if (inclusive == "minimum" && other > bound) || (inclusive == "maximum" && other < bound) {
+			isExclusive = false
+		} else {
+			inclusiveValue.Value = exclusiveValue.Value
+			inclusiveValue.Tag = exclusiveValue.Tag
+		}
+	} else {
+		node.Content = append(node.Content,
+			&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: inclusive},
+			&yaml.Node{Kind: yaml.ScalarNode, Tag: exclusiveValue.Tag, Value: exclusiveValue.Value},
+		)
+	}
+
+	exclusiveValue.Tag = "!!bool"
+	exclusiveValue.Value = strconv.FormatBool(isExclusive)
+	exclusiveValue.Style = 0
+	return true, nil
+}
+
+// mappingValue returns the value of key in the mapping node, or nil if it
+// isn't set.
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestConvertDocumentExclusiveBounds(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "numeric exclusive bounds",
+			input:    `{"type": "integer", "exclusiveMinimum": 0, "exclusiveMaximum": 10.5}`,
+			expected: `{"type": "integer", "exclusiveMinimum": true, "minimum": 0, "exclusiveMaximum": true, "maximum": 10.5}`,
+		},
+		{
+			name:     "inclusive bound is more restrictive",
+			input:    `{"minimum": 5, "exclusiveMinimum": 0}`,
+			expected: `{"minimum": 5, "exclusiveMinimum": false}`,
+		},
+		{
+			name:     "exclusive bound is more restrictive",
+			input:    `{"maximum": 5, "exclusiveMaximum": 1}`,
+			expected: `{"maximum": 1, "exclusiveMaximum": true}`,
+		},
+		{
+			name:     "nested schemas",
+			input:    `{"properties": {"exclusiveMinimum": {"type": "number", "exclusiveMinimum": 1}}}`,
+			expected: `{"properties": {"exclusiveMinimum": {"type": "number", "exclusiveMinimum": true, "minimum": 1}}}`,
+		},
+		{
+			name:     "example values are left as is",
+			input:    `{"example": {"exclusiveMinimum": 1}}`,
+			expected: `{"example": {"exclusiveMinimum": 1}}`,
+		},
+		{
+			name:     "OpenAPI 3.0 exclusive bounds are left as is",
+			input:    `{"minimum": 1, "exclusiveMinimum": true}`,
+			expected: `{"minimum": 1, "exclusiveMinimum": true}`,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			output, err := convertDocumentExclusiveBounds([]byte(tc.input))
+			require.NoError(t, err)
+
+			var expected, actual interface{}
+			require.NoError(t, yaml.Unmarshal([]byte(tc.expected), &expected))
+			require.NoError(t, yaml.Unmarshal(output, &actual))
+			assert.Equal(t, expected, actual)
+		})
+	}
+}